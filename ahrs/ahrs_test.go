@@ -0,0 +1,249 @@
+package ahrs_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/orsinium-labs/tinymath"
+	"github.com/orsinium-labs/tinymath/ahrs"
+)
+
+// The earth's magnetic field with the inclination of 60 degrees.
+var magEarth = [3]float64{math.Cos(math.Pi / 3), 0, -math.Sin(math.Pi / 3)}
+
+// A synthetic IMU trace generated with float64 precision.
+type trace struct {
+	// The true orientation, sensor-to-earth.
+	w, x, y, z float64
+	// The constant angular rate in the sensor frame.
+	rate [3]float64
+	// The constant gyroscope bias.
+	bias [3]float64
+}
+
+func newTrace(roll, pitch, yaw float64) *trace {
+	cr, sr := math.Cos(roll/2), math.Sin(roll/2)
+	cp, sp := math.Cos(pitch/2), math.Sin(pitch/2)
+	cy, sy := math.Cos(yaw/2), math.Sin(yaw/2)
+	return &trace{
+		w: cr*cp*cy + sr*sp*sy,
+		x: sr*cp*cy - cr*sp*sy,
+		y: cr*sp*cy + sr*cp*sy,
+		z: cr*cp*sy - sr*sp*cy,
+	}
+}
+
+// Rotate the earth-frame vector into the sensor frame.
+func (tr *trace) toSensor(v [3]float64) ahrs.Vector {
+	w, x, y, z := tr.w, tr.x, tr.y, tr.z
+	// The transposed rotation matrix of the quaternion.
+	return ahrs.Vector{
+		X: float32((1-2*(y*y+z*z))*v[0] + 2*(x*y+w*z)*v[1] + 2*(x*z-w*y)*v[2]),
+		Y: float32(2*(x*y-w*z)*v[0] + (1-2*(x*x+z*z))*v[1] + 2*(y*z+w*x)*v[2]),
+		Z: float32(2*(x*z+w*y)*v[0] + 2*(y*z-w*x)*v[1] + (1-2*(x*x+y*y))*v[2]),
+	}
+}
+
+// Advance the true orientation and return the sensor readings.
+func (tr *trace) step(dt float64) (gyro, accel, mag ahrs.Vector) {
+	gx, gy, gz := tr.rate[0], tr.rate[1], tr.rate[2]
+	// Integrate in small sub-steps to keep the reference precise.
+	const sub = 10
+	h := dt / sub / 2
+	for i := 0; i < sub; i++ {
+		w, x, y, z := tr.w, tr.x, tr.y, tr.z
+		tr.w += (-x*gx - y*gy - z*gz) * h
+		tr.x += (w*gx + y*gz - z*gy) * h
+		tr.y += (w*gy - x*gz + z*gx) * h
+		tr.z += (w*gz + x*gy - y*gx) * h
+		n := math.Sqrt(tr.w*tr.w + tr.x*tr.x + tr.y*tr.y + tr.z*tr.z)
+		tr.w, tr.x, tr.y, tr.z = tr.w/n, tr.x/n, tr.y/n, tr.z/n
+	}
+	gyro = ahrs.Vector{
+		X: float32(gx + tr.bias[0]),
+		Y: float32(gy + tr.bias[1]),
+		Z: float32(gz + tr.bias[2]),
+	}
+	// Scale readings to check that units don't matter.
+	accel = tr.toSensor([3]float64{0, 0, 9.81})
+	mag = tr.toSensor([3]float64{magEarth[0] * 50, magEarth[1] * 50, magEarth[2] * 50})
+	return gyro, accel, mag
+}
+
+func (tr *trace) euler() (roll, pitch, yaw float32) {
+	w, x, y, z := tr.w, tr.x, tr.y, tr.z
+	roll = float32(math.Atan2(2*(w*x+y*z), 1-2*(x*x+y*y)))
+	pitch = float32(math.Asin(2 * (w*y - x*z)))
+	yaw = float32(math.Atan2(2*(w*z+x*y), 1-2*(y*y+z*z)))
+	return roll, pitch, yaw
+}
+
+// The difference between two angles, wrapped into [-pi, pi].
+func angleDiff(a, b float32) float32 {
+	d := float64(a - b)
+	return float32(math.Remainder(d, 2*math.Pi))
+}
+
+func checkEuler(t *testing.T, f ahrs.Filter, tr *trace, eps float32, withYaw bool) {
+	t.Helper()
+	roll, pitch, yaw := f.Quaternion().Euler()
+	eroll, epitch, eyaw := tr.euler()
+	if tinymath.Abs(angleDiff(roll, eroll)) > eps {
+		t.Fatalf("roll: %f != %f", roll, eroll)
+	}
+	if tinymath.Abs(angleDiff(pitch, epitch)) > eps {
+		t.Fatalf("pitch: %f != %f", pitch, epitch)
+	}
+	if withYaw && tinymath.Abs(angleDiff(yaw, eyaw)) > eps {
+		t.Fatalf("yaw: %f != %f", yaw, eyaw)
+	}
+}
+
+func filters() map[string]func() ahrs.Filter {
+	return map[string]func() ahrs.Filter{
+		"madgwick": func() ahrs.Filter {
+			f := ahrs.NewMadgwick(0.5)
+			return &f
+		},
+		"mahony": func() ahrs.Filter {
+			f := ahrs.NewMahony(2, 0)
+			return &f
+		},
+		"complementary": func() ahrs.Filter {
+			f := ahrs.NewComplementary(0.98)
+			return &f
+		},
+	}
+}
+
+func TestQuaternionEuler(t *testing.T) {
+	t.Parallel()
+	for _, angles := range [][3]float32{
+		{0, 0, 0},
+		{0.3, -0.2, 0.5},
+		{-2.5, 1.0, -3.0},
+		{1.5, -1.2, 2.0},
+	} {
+		angles := angles
+		t.Run(fmt.Sprintf("%v", angles), func(t *testing.T) {
+			q := ahrs.FromEuler(angles[0], angles[1], angles[2])
+			roll, pitch, yaw := q.Euler()
+			eps := float32(0.02)
+			if tinymath.Abs(angleDiff(roll, angles[0])) > eps {
+				t.Fatalf("roll: %f != %f", roll, angles[0])
+			}
+			if tinymath.Abs(angleDiff(pitch, angles[1])) > 0.03 {
+				t.Fatalf("pitch: %f != %f", pitch, angles[1])
+			}
+			if tinymath.Abs(angleDiff(yaw, angles[2])) > eps {
+				t.Fatalf("yaw: %f != %f", yaw, angles[2])
+			}
+		})
+	}
+}
+
+func TestQuaternionRotate(t *testing.T) {
+	t.Parallel()
+	q := ahrs.FromEuler(0, 0, tinymath.FracPi2)
+	v := q.Rotate(ahrs.Vector{1, 0, 0})
+	if tinymath.Abs(v.X) > 0.01 || tinymath.Abs(v.Y-1) > 0.01 || tinymath.Abs(v.Z) > 0.01 {
+		t.Fatalf("%v", v)
+	}
+	// Rotating by the conjugate is the inverse rotation.
+	v = q.Conj().Rotate(v)
+	if tinymath.Abs(v.X-1) > 0.01 || tinymath.Abs(v.Y) > 0.01 {
+		t.Fatalf("%v", v)
+	}
+	n := q.Mul(q.Conj())
+	if tinymath.Abs(n.W-1) > 0.01 {
+		t.Fatalf("%v", n)
+	}
+}
+
+func TestStaticConvergence(t *testing.T) {
+	t.Parallel()
+	for name, newFilter := range filters() {
+		newFilter := newFilter
+		t.Run(name, func(t *testing.T) {
+			f := newFilter()
+			tr := newTrace(0.3, -0.2, 0.5)
+			for i := 0; i < 2000; i++ {
+				g, a, m := tr.step(0.01)
+				f.Update(g, a, m, 0.01)
+			}
+			checkEuler(t, f, tr, 0.03, true)
+		})
+	}
+}
+
+func TestStaticConvergenceIMU(t *testing.T) {
+	t.Parallel()
+	for name, newFilter := range filters() {
+		newFilter := newFilter
+		t.Run(name, func(t *testing.T) {
+			f := newFilter()
+			tr := newTrace(-0.4, 0.25, 0)
+			for i := 0; i < 2000; i++ {
+				g, a, _ := tr.step(0.01)
+				f.UpdateIMU(g, a, 0.01)
+			}
+			checkEuler(t, f, tr, 0.03, false)
+		})
+	}
+}
+
+func TestRotation(t *testing.T) {
+	t.Parallel()
+	for name, newFilter := range filters() {
+		newFilter := newFilter
+		t.Run(name, func(t *testing.T) {
+			f := newFilter()
+			tr := newTrace(0.1, 0.2, -0.3)
+			// Let the filter converge first.
+			for i := 0; i < 1000; i++ {
+				g, a, m := tr.step(0.01)
+				f.Update(g, a, m, 0.01)
+			}
+			// Then slowly rotate around all axes.
+			tr.rate = [3]float64{0.1, -0.05, 0.3}
+			for i := 0; i < 1000; i++ {
+				g, a, m := tr.step(0.01)
+				f.Update(g, a, m, 0.01)
+			}
+			checkEuler(t, f, tr, 0.05, true)
+		})
+	}
+}
+
+func TestMahonyBias(t *testing.T) {
+	t.Parallel()
+	tr := newTrace(0.2, 0.1, 1.0)
+	tr.bias = [3]float64{0.02, -0.01, 0.03}
+	f := ahrs.NewMahony(1, 0.3)
+	for i := 0; i < 10000; i++ {
+		g, a, m := tr.step(0.01)
+		f.Update(g, a, m, 0.01)
+	}
+	checkEuler(t, &f, tr, 0.03, true)
+	b := f.Bias()
+	if tinymath.Abs(b.X+0.02) > 0.005 || tinymath.Abs(b.Y-0.01) > 0.005 || tinymath.Abs(b.Z+0.03) > 0.005 {
+		t.Fatalf("%v", b)
+	}
+}
+
+func TestGyroOnly(t *testing.T) {
+	t.Parallel()
+	// Without accelerometer and magnetometer, the filters just integrate the gyroscope.
+	tr := newTrace(0, 0, 0)
+	tr.rate = [3]float64{0, 0, 1}
+	m := ahrs.NewMadgwick(0.5)
+	h := ahrs.NewMahony(1, 0.1)
+	for i := 0; i < 100; i++ {
+		g, _, _ := tr.step(0.01)
+		m.UpdateIMU(g, ahrs.Vector{}, 0.01)
+		h.UpdateIMU(g, ahrs.Vector{}, 0.01)
+	}
+	checkEuler(t, &m, tr, 0.01, true)
+	checkEuler(t, &h, tr, 0.01, true)
+}
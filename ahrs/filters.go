@@ -0,0 +1,316 @@
+package ahrs
+
+import "github.com/orsinium-labs/tinymath"
+
+// A sensor fusion filter.
+type Filter interface {
+	// Update the orientation using gyroscope, accelerometer, and magnetometer readings.
+	//
+	// If the magnetometer reading is zero, it is the same as [Filter.UpdateIMU].
+	Update(gyro, accel, mag Vector, dt float32)
+
+	// Update the orientation using only gyroscope and accelerometer readings.
+	//
+	// The heading is then based only on the gyroscope and will drift.
+	UpdateIMU(gyro, accel Vector, dt float32)
+
+	// The current orientation estimate.
+	Quaternion() Quaternion
+}
+
+var (
+	_ Filter = &Madgwick{}
+	_ Filter = &Mahony{}
+	_ Filter = &Complementary{}
+)
+
+// Madgwick's gradient descent orientation filter.
+//
+// https://x-io.co.uk/open-source-imu-and-ahrs-algorithms/
+type Madgwick struct {
+	// The gradient descent step size. Higher values converge faster
+	// but let more accelerometer noise through. A typical value is 0.1.
+	Beta float32
+
+	q Quaternion
+}
+
+// Create a new [Madgwick] filter starting at the [Identity] orientation.
+func NewMadgwick(beta float32) Madgwick {
+	return Madgwick{Beta: beta, q: Identity()}
+}
+
+func (f *Madgwick) Quaternion() Quaternion {
+	return f.q
+}
+
+// Override the current orientation estimate.
+func (f *Madgwick) SetQuaternion(q Quaternion) {
+	f.q = q
+}
+
+func (f *Madgwick) UpdateIMU(g, a Vector, dt float32) {
+	q0, q1, q2, q3 := f.q.W, f.q.X, f.q.Y, f.q.Z
+	qDot := f.q.derivative(g)
+
+	if !a.isZero() {
+		a = a.normalize()
+
+		_2q0 := 2 * q0
+		_2q1 := 2 * q1
+		_2q2 := 2 * q2
+		_2q3 := 2 * q3
+		_4q0 := 4 * q0
+		_4q1 := 4 * q1
+		_4q2 := 4 * q2
+		_8q1 := 8 * q1
+		_8q2 := 8 * q2
+		q0q0 := q0 * q0
+		q1q1 := q1 * q1
+		q2q2 := q2 * q2
+		q3q3 := q3 * q3
+
+		// The gradient of the objective function.
+		s := Quaternion{
+			W: _4q0*q2q2 + _2q2*a.X + _4q0*q1q1 - _2q1*a.Y,
+			X: _4q1*q3q3 - _2q3*a.X + 4*q0q0*q1 - _2q0*a.Y - _4q1 + _8q1*q1q1 + _8q1*q2q2 + _4q1*a.Z,
+			Y: 4*q0q0*q2 + _2q0*a.X + _4q2*q3q3 - _2q3*a.Y - _4q2 + _8q2*q1q1 + _8q2*q2q2 + _4q2*a.Z,
+			Z: 4*q1q1*q3 - _2q1*a.X + 4*q2q2*q3 - _2q2*a.Y,
+		}
+		qDot = qDot.Sub(s.normalizeOrZero().Scale(f.Beta))
+	}
+
+	f.q = f.q.Add(qDot.Scale(dt)).Normalize()
+}
+
+func (f *Madgwick) Update(g, a, m Vector, dt float32) {
+	if m.isZero() {
+		f.UpdateIMU(g, a, dt)
+		return
+	}
+	q0, q1, q2, q3 := f.q.W, f.q.X, f.q.Y, f.q.Z
+	qDot := f.q.derivative(g)
+
+	if !a.isZero() {
+		a = a.normalize()
+		m = m.normalize()
+
+		_2q0mx := 2 * q0 * m.X
+		_2q0my := 2 * q0 * m.Y
+		_2q0mz := 2 * q0 * m.Z
+		_2q1mx := 2 * q1 * m.X
+		_2q0 := 2 * q0
+		_2q1 := 2 * q1
+		_2q2 := 2 * q2
+		_2q3 := 2 * q3
+		_2q0q2 := 2 * q0 * q2
+		_2q2q3 := 2 * q2 * q3
+		q0q0 := q0 * q0
+		q0q1 := q0 * q1
+		q0q2 := q0 * q2
+		q0q3 := q0 * q3
+		q1q1 := q1 * q1
+		q1q2 := q1 * q2
+		q1q3 := q1 * q3
+		q2q2 := q2 * q2
+		q2q3 := q2 * q3
+		q3q3 := q3 * q3
+
+		// The direction of the earth's magnetic field in the earth frame.
+		// Only the horizontal (bx) and vertical (bz) components are used,
+		// so the heading error doesn't affect the attitude.
+		hx := m.X*q0q0 - _2q0my*q3 + _2q0mz*q2 + m.X*q1q1 + _2q1*m.Y*q2 + _2q1*m.Z*q3 - m.X*q2q2 - m.X*q3q3
+		hy := _2q0mx*q3 + m.Y*q0q0 - _2q0mz*q1 + _2q1mx*q2 - m.Y*q1q1 + m.Y*q2q2 + _2q2*m.Z*q3 - m.Y*q3q3
+		hz := -_2q0mx*q2 + _2q0my*q1 + m.Z*q0q0 + _2q1mx*q3 - m.Z*q1q1 + _2q2*m.Y*q3 - m.Z*q2q2 + m.Z*q3q3
+		_2bx := 2 * hypot(hx, hy)
+		_2bz := 2 * hz
+		_4bx := 2 * _2bx
+		_4bz := 2 * _2bz
+
+		// The errors of the predicted gravity (fa) and magnetic field (fm) directions.
+		fax := 2*q1q3 - _2q0q2 - a.X
+		fay := 2*q0q1 + _2q2q3 - a.Y
+		faz := 1 - 2*q1q1 - 2*q2q2 - a.Z
+		fmx := _2bx*(0.5-q2q2-q3q3) + _2bz*(q1q3-q0q2) - m.X
+		fmy := _2bx*(q1q2-q0q3) + _2bz*(q0q1+q2q3) - m.Y
+		fmz := _2bx*(q0q2+q1q3) + _2bz*(0.5-q1q1-q2q2) - m.Z
+
+		// The gradient of the objective function.
+		s := Quaternion{
+			W: -_2q2*fax + _2q1*fay - _2bz*q2*fmx + (-_2bx*q3+_2bz*q1)*fmy + _2bx*q2*fmz,
+			X: _2q3*fax + _2q0*fay - 4*q1*faz + _2bz*q3*fmx + (_2bx*q2+_2bz*q0)*fmy + (_2bx*q3-_4bz*q1)*fmz,
+			Y: -_2q0*fax + _2q3*fay - 4*q2*faz + (-_4bx*q2-_2bz*q0)*fmx + (_2bx*q1+_2bz*q3)*fmy + (_2bx*q0-_4bz*q2)*fmz,
+			Z: _2q1*fax + _2q2*fay + (-_4bx*q3+_2bz*q1)*fmx + (-_2bx*q0+_2bz*q2)*fmy + _2bx*q1*fmz,
+		}
+		qDot = qDot.Sub(s.normalizeOrZero().Scale(f.Beta))
+	}
+
+	f.q = f.q.Add(qDot.Scale(dt)).Normalize()
+}
+
+// Mahony's nonlinear complementary filter on the special orthogonal group.
+//
+// The gyroscope readings are corrected by a PI controller driven by the error
+// between the measured and the predicted directions of gravity and magnetic field.
+// The integral term compensates for the gyroscope bias.
+type Mahony struct {
+	// Proportional gain. A typical value is 1.
+	Kp float32
+	// Integral gain. Zero disables the gyroscope bias estimation.
+	Ki float32
+
+	q        Quaternion
+	integral Vector
+}
+
+// Create a new [Mahony] filter starting at the [Identity] orientation.
+func NewMahony(kp, ki float32) Mahony {
+	return Mahony{Kp: kp, Ki: ki, q: Identity()}
+}
+
+func (f *Mahony) Quaternion() Quaternion {
+	return f.q
+}
+
+// Override the current orientation estimate.
+func (f *Mahony) SetQuaternion(q Quaternion) {
+	f.q = q
+}
+
+// The estimated gyroscope bias (with the negative sign).
+func (f *Mahony) Bias() Vector {
+	return f.integral
+}
+
+func (f *Mahony) UpdateIMU(g, a Vector, dt float32) {
+	f.Update(g, a, Vector{}, dt)
+}
+
+func (f *Mahony) Update(g, a, m Vector, dt float32) {
+	if a.isZero() {
+		f.q = f.q.integrate(g, dt).Normalize()
+		return
+	}
+	q0, q1, q2, q3 := f.q.W, f.q.X, f.q.Y, f.q.Z
+	q0q0 := q0 * q0
+	q0q1 := q0 * q1
+	q0q2 := q0 * q2
+	q0q3 := q0 * q3
+	q1q1 := q1 * q1
+	q1q2 := q1 * q2
+	q1q3 := q1 * q3
+	q2q2 := q2 * q2
+	q2q3 := q2 * q3
+	q3q3 := q3 * q3
+
+	// The cross product of the measured and the predicted direction of gravity.
+	a = a.normalize()
+	vx := 2 * (q1q3 - q0q2)
+	vy := 2 * (q0q1 + q2q3)
+	vz := 2 * (q0q0 - 0.5 + q3q3)
+	e := Vector{
+		X: a.Y*vz - a.Z*vy,
+		Y: a.Z*vx - a.X*vz,
+		Z: a.X*vy - a.Y*vx,
+	}
+
+	if !m.isZero() {
+		m = m.normalize()
+		// The earth's magnetic field projected into the X-Z plane of the earth frame.
+		hx := 2 * (m.X*(0.5-q2q2-q3q3) + m.Y*(q1q2-q0q3) + m.Z*(q1q3+q0q2))
+		hy := 2 * (m.X*(q1q2+q0q3) + m.Y*(0.5-q1q1-q3q3) + m.Z*(q2q3-q0q1))
+		bx := hypot(hx, hy)
+		bz := 2 * (m.X*(q1q3-q0q2) + m.Y*(q2q3+q0q1) + m.Z*(0.5-q1q1-q2q2))
+		// The predicted direction of the magnetic field in the sensor frame.
+		wx := 2 * (bx*(0.5-q2q2-q3q3) + bz*(q1q3-q0q2))
+		wy := 2 * (bx*(q1q2-q0q3) + bz*(q0q1+q2q3))
+		wz := 2 * (bx*(q0q2+q1q3) + bz*(0.5-q1q1-q2q2))
+		e.X += m.Y*wz - m.Z*wy
+		e.Y += m.Z*wx - m.X*wz
+		e.Z += m.X*wy - m.Y*wx
+	}
+
+	if f.Ki > 0 {
+		f.integral.X += f.Ki * e.X * dt
+		f.integral.Y += f.Ki * e.Y * dt
+		f.integral.Z += f.Ki * e.Z * dt
+	} else {
+		f.integral = Vector{}
+	}
+	g.X += f.Kp*e.X + f.integral.X
+	g.Y += f.Kp*e.Y + f.integral.Y
+	g.Z += f.Kp*e.Z + f.integral.Z
+
+	f.q = f.q.integrate(g, dt).Normalize()
+}
+
+// A complementary filter that blends the Euler angles integrated from the gyroscope
+// with the absolute angles calculated from the accelerometer and magnetometer.
+//
+// It's the cheapest of the filters but it suffers from the gimbal lock
+// when the pitch approaches ±90°.
+type Complementary struct {
+	// How much to trust the gyroscope, in the `[0, 1]` range. A typical value is 0.98.
+	Alpha float32
+
+	roll, pitch, yaw float32
+	initialized      bool
+}
+
+// Create a new [Complementary] filter.
+//
+// The initial orientation is taken from the first accelerometer
+// (and magnetometer) reading.
+func NewComplementary(alpha float32) Complementary {
+	return Complementary{Alpha: alpha}
+}
+
+func (f *Complementary) Quaternion() Quaternion {
+	return FromEuler(f.roll, f.pitch, f.yaw)
+}
+
+// The current orientation estimate as Euler angles in radians.
+func (f *Complementary) Euler() (roll, pitch, yaw float32) {
+	return f.roll, f.pitch, f.yaw
+}
+
+func (f *Complementary) UpdateIMU(g, a Vector, dt float32) {
+	f.Update(g, a, Vector{}, dt)
+}
+
+func (f *Complementary) Update(g, a, m Vector, dt float32) {
+	// Euler angle rates from the body angular rates.
+	sr, cr := tinymath.SinCos(f.roll)
+	sp, cp := tinymath.SinCos(f.pitch)
+	f.roll += (g.X + (g.Y*sr+g.Z*cr)*sp/cp) * dt
+	f.pitch += (g.Y*cr - g.Z*sr) * dt
+	f.yaw += (g.Y*sr + g.Z*cr) / cp * dt
+
+	k := 1 - f.Alpha
+	if !f.initialized {
+		k = 1
+	}
+	if !a.isZero() {
+		roll := tinymath.Atan2(a.Y, a.Z)
+		pitch := tinymath.Atan2(-a.X, hypot(a.Y, a.Z))
+		f.roll += k * wrapPi(roll-f.roll)
+		f.pitch += k * (pitch - f.pitch)
+		f.initialized = true
+	}
+	if !m.isZero() {
+		// Tilt-compensated heading.
+		sr, cr := tinymath.SinCos(f.roll)
+		sp, cp := tinymath.SinCos(f.pitch)
+		mx := m.X*cp + (m.Y*sr+m.Z*cr)*sp
+		my := m.Y*cr - m.Z*sr
+		yaw := tinymath.Atan2(-my, mx)
+		f.yaw += k * wrapPi(yaw-f.yaw)
+	}
+	f.roll = wrapPi(f.roll)
+	f.yaw = wrapPi(f.yaw)
+}
+
+// Wrap the angle into the `[-pi, pi)` range.
+func wrapPi(x float32) float32 {
+	return tinymath.RemEuclid(x+tinymath.Pi, tinymath.Tau) - tinymath.Pi
+}
@@ -0,0 +1,150 @@
+// Package ahrs fuses accelerometer, gyroscope, and magnetometer readings
+// into the orientation (attitude and heading) of the sensor.
+//
+// All filters use the same conventions:
+//
+//   - Gyroscope readings are angular rates in radians per second.
+//   - Accelerometer and magnetometer readings can be in any units,
+//     only their direction matters.
+//   - The accelerometer measures the reaction to gravity,
+//     so it reports +1g on the Z axis when the sensor lies flat.
+//   - The orientation is a rotation from the sensor frame to the earth frame
+//     (X points to the magnetic north, Z points up).
+//   - Euler angles are Tait-Bryan angles in the Z-Y-X order (yaw, pitch, roll).
+package ahrs
+
+import "github.com/orsinium-labs/tinymath"
+
+// A 3D vector of sensor readings.
+type Vector struct {
+	X, Y, Z float32
+}
+
+func (v Vector) isZero() bool {
+	return v.X == 0 && v.Y == 0 && v.Z == 0
+}
+
+func (v Vector) normalize() Vector {
+	n := invSqrt(v.X*v.X + v.Y*v.Y + v.Z*v.Z)
+	return Vector{v.X * n, v.Y * n, v.Z * n}
+}
+
+// A rotation quaternion.
+type Quaternion struct {
+	W, X, Y, Z float32
+}
+
+// The quaternion representing no rotation.
+func Identity() Quaternion {
+	return Quaternion{W: 1}
+}
+
+// Create a quaternion from Euler angles in radians.
+func FromEuler(roll, pitch, yaw float32) Quaternion {
+	sr, cr := tinymath.SinCos(roll / 2)
+	sp, cp := tinymath.SinCos(pitch / 2)
+	sy, cy := tinymath.SinCos(yaw / 2)
+	return Quaternion{
+		W: cr*cp*cy + sr*sp*sy,
+		X: sr*cp*cy - cr*sp*sy,
+		Y: cr*sp*cy + sr*cp*sy,
+		Z: cr*cp*sy - sr*sp*cy,
+	}
+}
+
+// The Hamilton product of two quaternions.
+//
+// The result represents the rotation `r` followed by the rotation `q`.
+func (q Quaternion) Mul(r Quaternion) Quaternion {
+	return Quaternion{
+		W: q.W*r.W - q.X*r.X - q.Y*r.Y - q.Z*r.Z,
+		X: q.W*r.X + q.X*r.W + q.Y*r.Z - q.Z*r.Y,
+		Y: q.W*r.Y - q.X*r.Z + q.Y*r.W + q.Z*r.X,
+		Z: q.W*r.Z + q.X*r.Y - q.Y*r.X + q.Z*r.W,
+	}
+}
+
+// The conjugate quaternion which, for unit quaternions, is the inverse rotation.
+func (q Quaternion) Conj() Quaternion {
+	return Quaternion{q.W, -q.X, -q.Y, -q.Z}
+}
+
+// Scale the quaternion to the unit length.
+func (q Quaternion) Normalize() Quaternion {
+	n := invSqrt(q.W*q.W + q.X*q.X + q.Y*q.Y + q.Z*q.Z)
+	return Quaternion{q.W * n, q.X * n, q.Y * n, q.Z * n}
+}
+
+func (q Quaternion) Add(r Quaternion) Quaternion {
+	return Quaternion{q.W + r.W, q.X + r.X, q.Y + r.Y, q.Z + r.Z}
+}
+
+func (q Quaternion) Sub(r Quaternion) Quaternion {
+	return Quaternion{q.W - r.W, q.X - r.X, q.Y - r.Y, q.Z - r.Z}
+}
+
+func (q Quaternion) Scale(k float32) Quaternion {
+	return Quaternion{q.W * k, q.X * k, q.Y * k, q.Z * k}
+}
+
+// Rotate the vector by the quaternion.
+func (q Quaternion) Rotate(v Vector) Vector {
+	r := q.Mul(Quaternion{0, v.X, v.Y, v.Z}).Mul(q.Conj())
+	return Vector{r.X, r.Y, r.Z}
+}
+
+// Convert the quaternion into Euler angles in radians.
+//
+// Roll and yaw are in the `[-pi, pi]` range, pitch is in the `[-pi/2, pi/2]` range.
+func (q Quaternion) Euler() (roll, pitch, yaw float32) {
+	roll = tinymath.Atan2(2*(q.W*q.X+q.Y*q.Z), 1-2*(q.X*q.X+q.Y*q.Y))
+	sinp := tinymath.Clamp(2*(q.W*q.Y-q.X*q.Z), -1, 1)
+	pitch = tinymath.Asin(sinp)
+	yaw = tinymath.Atan2(2*(q.W*q.Z+q.X*q.Y), 1-2*(q.Y*q.Y+q.Z*q.Z))
+	return roll, pitch, yaw
+}
+
+// The rate of change of the quaternion rotating with the angular rate `g`.
+func (q Quaternion) derivative(g Vector) Quaternion {
+	return Quaternion{
+		W: 0.5 * (-q.X*g.X - q.Y*g.Y - q.Z*g.Z),
+		X: 0.5 * (q.W*g.X + q.Y*g.Z - q.Z*g.Y),
+		Y: 0.5 * (q.W*g.Y - q.X*g.Z + q.Z*g.X),
+		Z: 0.5 * (q.W*g.Z + q.X*g.Y - q.Y*g.X),
+	}
+}
+
+// Integrate the angular rate over the time step.
+func (q Quaternion) integrate(g Vector, dt float32) Quaternion {
+	return q.Add(q.derivative(g).Scale(dt))
+}
+
+// Scale the quaternion to the unit length or leave it as is if it's zero.
+func (q Quaternion) normalizeOrZero() Quaternion {
+	n := q.W*q.W + q.X*q.X + q.Y*q.Y + q.Z*q.Z
+	if n == 0 {
+		return q
+	}
+	return q.Scale(invSqrt(n))
+}
+
+// The fast inverse square root refined with two Newton-Raphson iterations.
+//
+// Normalization is done on every filter step, so the ~5% error
+// of [tinymath.InvSqrt] alone would make the quaternion shrink.
+func invSqrt(x float32) float32 {
+	y := tinymath.InvSqrt(x)
+	half := 0.5 * x
+	y *= 1.5 - half*y*y
+	y *= 1.5 - half*y*y
+	return y
+}
+
+// The length of the hypotenuse, more precise than [tinymath.Hypot].
+func hypot(x, y float32) float32 {
+	n := x*x + y*y
+	if n == 0 {
+		return 0
+	}
+	return n * invSqrt(n)
+}
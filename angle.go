@@ -0,0 +1,151 @@
+package tinymath
+
+// Converts an angle from radians to degrees.
+func ToDegrees(self float32) float32 {
+	return self * (180.0 / Pi)
+}
+
+// Converts an angle from degrees to radians.
+func ToRadians(self float32) float32 {
+	return self * (Pi / 180.0)
+}
+
+// Converts an angle from radians to turns (full rotations).
+func ToTurns(self float32) float32 {
+	return self * (1.0 / Tau)
+}
+
+// Converts an angle from turns (full rotations) to radians.
+func FromTurns(self float32) float32 {
+	return self * Tau
+}
+
+// Wraps an angle in radians into the `[0, τ)` range.
+//
+// Like [Sin], it reduces big angles without losing precision.
+// Returns [NaN] for infinities and NaN.
+func WrapTau(self float32) float32 {
+	if self >= 0 && self < Tau {
+		return self
+	}
+	r := float32(turnsFract(self) * tau64)
+	// For tiny negative angles, the fraction is rounded up to a full turn.
+	if r >= Tau {
+		return 0
+	}
+	return r
+}
+
+// Wraps an angle in radians into the `[-π, π)` range.
+//
+// Like [Sin], it reduces big angles without losing precision.
+// Returns [NaN] for infinities and NaN.
+func WrapPi(self float32) float32 {
+	if self >= -Pi && self < Pi {
+		return self
+	}
+	f := turnsFract(self)
+	if f >= 0.5 {
+		f--
+	}
+	r := float32(f * tau64)
+	if r >= Pi {
+		return -Pi
+	}
+	return r
+}
+
+// τ with float64 precision.
+const tau64 float64 = 6.28318530717958647692528676655900577
+
+// Returns the fractional part of an angle in turns, in the `[0, 1)` range.
+//
+// Returns NaN for infinities and NaN.
+func turnsFract(x float32) float64 {
+	if x-x != 0 {
+		return float64(NaN)
+	}
+	t := float64(x) * (1 / tau64)
+	if Abs(x) > 1<<30 {
+		// float64 has too few bits left for the fraction of huge angles.
+		t = float64(payneHanek(x))
+	}
+	f := t - float64(int64(t))
+	if f < 0 {
+		f++
+	}
+	// For tiny negative fractions, the sum is rounded up to 1.
+	if f >= 1 {
+		return 0
+	}
+	return f
+}
+
+// Computes the shortest signed difference in radians from the angle `a` to the angle `b`.
+//
+// The result is in the `[-π, π)` range: positive if the shortest way
+// from `a` to `b` is counterclockwise and negative if clockwise.
+func AngleDiff(a, b float32) float32 {
+	return WrapPi(b - a)
+}
+
+// Interpolates between the angles `a` and `b` in radians along the shortest arc.
+//
+// Returns `a` if `t` is `0` and an angle equal to `b` (maybe not wrapped) if `t` is `1`.
+func AngleLerp(a, b, t float32) float32 {
+	return a + AngleDiff(a, b)*t
+}
+
+// Approximates `sin(x)` for an angle in degrees.
+//
+// Unlike `Sin(ToRadians(x))`, the angle is reduced modulo 360° exactly,
+// so it's precise for any angle and exact at multiples of 90°.
+// Returns [NaN] for infinities and NaN.
+func SinDeg(self float32) float32 {
+	return cosTurns(reduceDegrees(self)*(1.0/360.0) - 0.25)
+}
+
+// Approximates `cos(x)` for an angle in degrees.
+//
+// Like [SinDeg], it's precise for any angle.
+func CosDeg(self float32) float32 {
+	return cosTurns(reduceDegrees(self) * (1.0 / 360.0))
+}
+
+// Approximates `sin(x)` for an angle in turns (full rotations).
+//
+// Whole turns are dropped exactly, so it's precise for any angle.
+// Returns [NaN] for infinities and NaN.
+func SinTurns(self float32) float32 {
+	return cosTurns(self - Round(self) - 0.25)
+}
+
+// Approximates `cos(x)` for an angle in turns (full rotations).
+//
+// Like [SinTurns], it's precise for any angle.
+func CosTurns(self float32) float32 {
+	return cosTurns(self - Round(self))
+}
+
+// Reduces an angle in degrees modulo 360° without rounding errors.
+//
+// The result is in `(-360, 360)` and points in the same direction as `x`.
+// Returns NaN for infinities and NaN.
+func reduceDegrees(x float32) float32 {
+	a := Abs(x)
+	if a < 1<<24 {
+		// k*360 fits into 24 bits, so both the product and the difference are exact.
+		return x - 360*Round(x*(1.0/360.0))
+	}
+	exp := int32(extractExponentBits(x))
+	if exp == 0xff {
+		return NaN
+	}
+	// x = m * 2^e, where m is an integer and e > 0, so x mod 360 = (m mod 360)*(2^e mod 360) mod 360.
+	m := uint64(ToBits(a)&^expMask | 1<<mantissaBits)
+	p := uint64(1)
+	for e := exp - expBias - mantissaBits; e > 0; e-- {
+		p = p * 2 % 360
+	}
+	return CopySign(float32(m%360*p%360), x)
+}
@@ -0,0 +1,186 @@
+package tinymath_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/orsinium-labs/tinymath"
+)
+
+func TestAngleConversions(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		rad, deg, turns float32
+	}{
+		{0, 0, 0},
+		{tinymath.FracPi6, 30, 1.0 / 12},
+		{tinymath.FracPi2, 90, 0.25},
+		{tinymath.Pi, 180, 0.5},
+		{-tinymath.Pi, -180, -0.5},
+		{tinymath.Tau, 360, 1},
+		{3 * tinymath.Tau, 1080, 3},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("%g", c.deg), func(t *testing.T) {
+			close(t, tinymath.ToDegrees(c.rad), c.deg, 1e-4)
+			close(t, tinymath.ToRadians(c.deg), c.rad, 1e-6)
+			close(t, tinymath.ToTurns(c.rad), c.turns, 1e-6)
+			close(t, tinymath.FromTurns(c.turns), c.rad, 1e-6)
+		})
+	}
+}
+
+func TestWrap(t *testing.T) {
+	t.Parallel()
+	for i := -2000; i <= 2000; i++ {
+		x := float32(i) * 0.01
+		tau := tinymath.WrapTau(x)
+		if tau < 0 || tau >= tinymath.Tau {
+			t.Fatalf("WrapTau(%g) = %g", x, tau)
+		}
+		pi := tinymath.WrapPi(x)
+		if pi < -tinymath.Pi || pi >= tinymath.Pi {
+			t.Fatalf("WrapPi(%g) = %g", x, pi)
+		}
+		// Wrapped angles must point in the same direction.
+		close(t, float32(math.Cos(float64(tau))), float32(math.Cos(float64(x))), 1e-4)
+		close(t, float32(math.Sin(float64(pi))), float32(math.Sin(float64(x))), 1e-4)
+	}
+	// Big angles.
+	for x := 20.0; x < 1e38; x *= 1.01 {
+		for _, x := range []float32{float32(x), -float32(x)} {
+			tau := tinymath.WrapTau(x)
+			if tau < 0 || tau >= tinymath.Tau {
+				t.Fatalf("WrapTau(%g) = %g", x, tau)
+			}
+			pi := tinymath.WrapPi(x)
+			if pi < -tinymath.Pi || pi >= tinymath.Pi {
+				t.Fatalf("WrapPi(%g) = %g", x, pi)
+			}
+			diff := tinymath.AngleDiff(x, 0)
+			if diff < -tinymath.Pi || diff >= tinymath.Pi {
+				t.Fatalf("AngleDiff(%g, 0) = %g", x, diff)
+			}
+			// Sin reduces big angles exactly, so it can be used as a reference.
+			sin := tinymath.Sin(x)
+			close(t, tinymath.Sin(tau), sin, 1e-4)
+			close(t, tinymath.Sin(pi), sin, 1e-4)
+			close(t, tinymath.Sin(-diff), sin, 1e-4)
+			if tinymath.Abs(x) < 1e9 {
+				exp := math.Mod(float64(x), 2*math.Pi)
+				if exp < 0 {
+					exp += 2 * math.Pi
+				}
+				close(t, float32(math.Cos(float64(tau))), float32(math.Cos(exp)), 1e-6)
+				close(t, float32(math.Sin(float64(tau))), float32(math.Sin(exp)), 1e-6)
+				close(t, float32(math.Sin(float64(pi))), float32(math.Sin(exp)), 1e-6)
+			}
+		}
+	}
+	close(t, tinymath.WrapTau(1e5), float32(math.Mod(1e5, 2*math.Pi)), 1e-6)
+	eq(t, tinymath.WrapTau(1), 1)
+	eq(t, tinymath.WrapPi(-1), -1)
+	// float32 π is a bit bigger than π, so it wraps to a bit more than -π.
+	close(t, tinymath.WrapPi(tinymath.Pi), -tinymath.Pi, 3e-7)
+	eq(t, tinymath.WrapPi(-tinymath.Pi), -tinymath.Pi)
+	close(t, tinymath.WrapTau(tinymath.Tau), 0, 3e-7)
+	eq(t, tinymath.WrapTau(-1e-9), 0)
+	if !tinymath.IsNaN(tinymath.WrapPi(tinymath.Inf)) {
+		t.Fatal("WrapPi(Inf) must be NaN")
+	}
+}
+
+func TestAngleDiff(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		a, b, exp float32
+	}{
+		{0, 10, 10},
+		{10, 0, -10},
+		{350, 10, 20},
+		{10, 350, -20},
+		{-170, 170, -20},
+		{720, 45, 45},
+		{0, 180, -180},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("%g_%g", c.a, c.b), func(t *testing.T) {
+			a := tinymath.ToRadians(c.a)
+			b := tinymath.ToRadians(c.b)
+			act := tinymath.ToDegrees(tinymath.AngleDiff(a, b))
+			close(t, act, c.exp, 1e-3)
+		})
+	}
+}
+
+func TestAngleLerp(t *testing.T) {
+	t.Parallel()
+	a := tinymath.ToRadians(350)
+	b := tinymath.ToRadians(10)
+	eq(t, tinymath.AngleLerp(a, b, 0), a)
+	close(t, tinymath.AngleDiff(tinymath.AngleLerp(a, b, 0.5), 0), 0, 1e-5)
+	close(t, tinymath.AngleDiff(tinymath.AngleLerp(a, b, 1), b), 0, 1e-5)
+	close(t, tinymath.ToDegrees(tinymath.AngleLerp(a, b, 0.25)), 355, 1e-3)
+}
+
+func TestTrigVariants(t *testing.T) {
+	t.Parallel()
+	for i := -3600; i <= 3600; i++ {
+		deg := float32(i) * 0.5
+		rad := float64(deg) * math.Pi / 180
+		close(t, tinymath.SinDeg(deg), float32(math.Sin(rad)), 0.002)
+		close(t, tinymath.CosDeg(deg), float32(math.Cos(rad)), 0.002)
+		turns := deg / 360
+		close(t, tinymath.SinTurns(turns), float32(math.Sin(rad)), 0.002)
+		close(t, tinymath.CosTurns(turns), float32(math.Cos(rad)), 0.002)
+	}
+	// Exact at the key points.
+	eq(t, tinymath.SinDeg(90), 1)
+	eq(t, tinymath.CosDeg(0), 1)
+	eq(t, tinymath.CosDeg(180), -1)
+	eq(t, tinymath.SinTurns(0.5), 0)
+	// A big angle in degrees doesn't lose as much precision as in radians.
+	close(t, tinymath.SinDeg(36000+30), 0.5, 0.002)
+}
+
+func TestTrigVariantsHuge(t *testing.T) {
+	t.Parallel()
+	for x := 100.0; x < 1e38; x *= 1.37 {
+		for _, sign := range []float32{1, -1} {
+			x := float32(x) * sign
+			// Both reductions are exact in float64.
+			rad := math.Mod(float64(x), 360) * math.Pi / 180
+			close(t, tinymath.SinDeg(x), float32(math.Sin(rad)), 0.002)
+			close(t, tinymath.CosDeg(x), float32(math.Cos(rad)), 0.002)
+			rad = (float64(x) - math.Round(float64(x))) * 2 * math.Pi
+			close(t, tinymath.SinTurns(x), float32(math.Sin(rad)), 0.002)
+			close(t, tinymath.CosTurns(x), float32(math.Cos(rad)), 0.002)
+		}
+	}
+	// Whole turns.
+	for _, x := range []float32{1, 7, 1 << 22, 1<<22 + 1, 1 << 23, 1e8, -1e8, 1e30} {
+		eq(t, tinymath.SinTurns(x), 0)
+		eq(t, tinymath.CosTurns(x), 1)
+	}
+	// Whole turns in degrees, the products are exact.
+	for _, x := range []float32{1, 7, 1 << 20, 1 << 24, 1 << 40, -(1 << 30)} {
+		eq(t, tinymath.CosDeg(x*360), 1)
+		eq(t, tinymath.SinDeg(x*360), 0)
+	}
+	close(t, tinymath.CosTurns(1<<22+0.5), -1, 1e-6)
+	close(t, tinymath.SinTurns(1<<21+0.25), 1, 1e-6)
+	eq(t, tinymath.CosDeg(3.6e9), 1)
+	close(t, tinymath.CosDeg(3.6e9+256), tinymath.CosDeg(256), 1e-6)
+	big := float32(-1e30)
+	close(t, tinymath.SinDeg(big), float32(math.Sin(math.Mod(float64(big), 360)*math.Pi/180)), 0.002)
+	for _, x := range []float32{tinymath.Inf, tinymath.NegInf, tinymath.NaN} {
+		for _, f := range []func(float32) float32{tinymath.SinDeg, tinymath.CosDeg, tinymath.SinTurns, tinymath.CosTurns} {
+			if !tinymath.IsNaN(f(x)) {
+				t.Fatalf("%g: %g", x, f(x))
+			}
+		}
+	}
+}
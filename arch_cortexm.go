@@ -0,0 +1,46 @@
+//go:build cortexm && fpu
+
+package tinymath
+
+// Functions for ARM Cortex-M chips with a single-precision FPU (Cortex-M4F, Cortex-M7).
+//
+// TinyGo targets for these chips use the soft-float ABI by default,
+// so the FPU is used only if the target enables it and the `fpu` build tag is set.
+//
+// FPv4-SP (Cortex-M4F) has no rounding instructions, and VFMA.F32
+// can't be reached from Go because math.FMA works with float64.
+// Abs and CopySign are bit operations (a single BIC or BFI) and don't need VABS.
+
+import (
+	"math"
+	"math/bits"
+)
+
+func Ceil(self float32) float32 {
+	return ceilSoft(self)
+}
+
+func Floor(self float32) float32 {
+	return floorSoft(self)
+}
+
+func FMA(x, y, z float32) float32 {
+	return fmaSoft(x, y, z)
+}
+
+// VSQRT.F32. LLVM narrows the float64 sqrt of a float32 value into float32.
+func Sqrt(self float32) float32 {
+	return float32(math.Sqrt(float64(self)))
+}
+
+func Trunc(self float32) float32 {
+	return truncSoft(self)
+}
+
+// VSQRT.F32 is exact.
+const sqrtExact = true
+
+// CLZ, available on all ARMv7-M chips.
+func leadingZeros(x uint32) uint32 {
+	return uint32(bits.LeadingZeros32(x))
+}
@@ -0,0 +1,45 @@
+//go:build !tinygo.wasm && !(cortexm && fpu) && !(tinygo.riscv && fpu)
+
+package tinymath
+
+// Functions that can be optimized for specific architectures.
+// This is the fallback for all other architectures (and for the regular Go),
+// see soft.go for the implementations.
+
+// Returns the smallest integer greater than or equal to a number.
+func Ceil(self float32) float32 {
+	return ceilSoft(self)
+}
+
+// Returns the largest integer less than or equal to a number.
+func Floor(self float32) float32 {
+	return floorSoft(self)
+}
+
+// Approximates the square root of a number with an average deviation of ~5%.
+//
+// Returns [`NAN`] if `self` is a negative number.
+func Sqrt(self float32) float32 {
+	return sqrtSoft(self)
+}
+
+// Computes `x*y + z` with only one rounding (fused multiply-add).
+//
+// The result is exact as if computed with infinite precision and then rounded.
+// It's slower than `x*y + z` but can be used to compute the rounding error
+// of a multiplication: `FMA(x, y, -x*y)`.
+func FMA(x, y, z float32) float32 {
+	return fmaSoft(x, y, z)
+}
+
+// Returns the integer part of a number.
+func Trunc(self float32) float32 {
+	return truncSoft(self)
+}
+
+// [Sqrt] above is an approximation.
+const sqrtExact = false
+
+func leadingZeros(x uint32) uint32 {
+	return leadingZerosSoft(x)
+}
@@ -0,0 +1,42 @@
+//go:build tinygo.riscv && fpu
+
+package tinymath
+
+// Functions for RISC-V chips with the F extension (single-precision floats).
+//
+// Most RISC-V microcontrollers (like ESP32-C3 or FE310) don't have the F extension,
+// so it's enabled only with the `fpu` build tag.
+//
+// RISC-V has no rounding instructions for floats and the base ISA has no CLZ
+// (it's in the Zbb extension), so everything else uses the software fallback.
+
+import "math"
+
+func Ceil(self float32) float32 {
+	return ceilSoft(self)
+}
+
+func Floor(self float32) float32 {
+	return floorSoft(self)
+}
+
+// FMADD.S can't be reached from Go because math.FMA works with float64.
+func FMA(x, y, z float32) float32 {
+	return fmaSoft(x, y, z)
+}
+
+// FSQRT.S. LLVM narrows the float64 sqrt of a float32 value into float32.
+func Sqrt(self float32) float32 {
+	return float32(math.Sqrt(float64(self)))
+}
+
+func Trunc(self float32) float32 {
+	return truncSoft(self)
+}
+
+// FSQRT.S is exact.
+const sqrtExact = true
+
+func leadingZeros(x uint32) uint32 {
+	return leadingZerosSoft(x)
+}
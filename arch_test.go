@@ -0,0 +1,183 @@
+package tinymath_test
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"math"
+	"math/bits"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/orsinium-labs/tinymath"
+)
+
+// Which instruction each function becomes on each architecture.
+//
+// "soft" means the software fallback from soft.go.
+// Cortex-M and RISC-V columns require the `fpu` build tag (see arch_cortexm.go and arch_riscvf.go).
+// The regular Go compiler always uses the software fallback (see arch_generic.go).
+//
+// [TestArchMatrix] checks the matrix against the arch files.
+var archMatrix = []struct {
+	Name    string
+	Wasm    string
+	CortexM string
+	RISCV   string
+}{
+	{"Ceil", "f32.ceil", "soft", "soft"},
+	{"Floor", "f32.floor", "soft", "soft"},
+	{"Trunc", "f32.trunc", "soft", "soft"},
+	{"Sqrt", "f32.sqrt", "VSQRT.F32", "FSQRT.S"},
+	{"FMA", "soft", "soft", "soft"},
+}
+
+// Reads which instruction each function in the arch file becomes.
+//
+// A function that only calls a software fallback (like `return sqrtSoft(self)`)
+// is "soft". For any other function, the instruction is the first word
+// of its doc comment.
+func archInstructions(t *testing.T, path string) map[string]string {
+	t.Helper()
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	instructions := make(map[string]string)
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+		instructions[fn.Name.Name] = ""
+		if len(fn.Body.List) == 1 {
+			ret, ok := fn.Body.List[0].(*ast.ReturnStmt)
+			if ok && len(ret.Results) == 1 {
+				call, ok := ret.Results[0].(*ast.CallExpr)
+				if ok {
+					ident, ok := call.Fun.(*ast.Ident)
+					if ok && strings.HasSuffix(ident.Name, "Soft") {
+						instructions[fn.Name.Name] = "soft"
+						continue
+					}
+				}
+			}
+		}
+		if fn.Doc != nil {
+			words := strings.Fields(fn.Doc.Text())
+			if len(words) > 0 {
+				instructions[fn.Name.Name] = strings.TrimRight(words[0], ".,")
+			}
+		}
+	}
+	return instructions
+}
+
+func TestArchMatrix(t *testing.T) {
+	t.Parallel()
+	wasm := archInstructions(t, "arch_tinygo.go")
+	cortexm := archInstructions(t, "arch_cortexm.go")
+	riscv := archInstructions(t, "arch_riscvf.go")
+	generic := archInstructions(t, "arch_generic.go")
+	for _, f := range archMatrix {
+		f := f
+		t.Run(f.Name, func(t *testing.T) {
+			t.Parallel()
+			if wasm[f.Name] != f.Wasm {
+				t.Errorf("wasm: %q != %q", wasm[f.Name], f.Wasm)
+			}
+			if cortexm[f.Name] != f.CortexM {
+				t.Errorf("cortexm: %q != %q", cortexm[f.Name], f.CortexM)
+			}
+			if riscv[f.Name] != f.RISCV {
+				t.Errorf("riscv: %q != %q", riscv[f.Name], f.RISCV)
+			}
+			if generic[f.Name] != "soft" {
+				t.Errorf("generic: %q != %q", generic[f.Name], "soft")
+			}
+		})
+	}
+	// Every float function of the arch files must be in the matrix.
+	for name := range wasm {
+		if name == "leadingZeros" {
+			continue
+		}
+		found := false
+		for _, f := range archMatrix {
+			found = found || f.Name == name
+		}
+		if !found {
+			t.Errorf("%s is not in the matrix", name)
+		}
+	}
+}
+
+// Samples for checking exact functions, including special values
+// and values around the limits of the integer conversion.
+func archSamples() []float32 {
+	xs := []float32{
+		0, 0.5, 1, 1.5, 2.5, 1e-40, 0x1p23 - 0.5, 0x1p23, 0x1p23 + 1,
+		0x1p31 - 128, 0x1p31, 0x1p31 + 256, 0x1p32, 1e30,
+		tinymath.Inf, tinymath.NaN,
+	}
+	for _, x := range xs[:len(xs)-1] {
+		xs = append(xs, -x)
+	}
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 10_000; i++ {
+		xs = append(xs, float32(rng.NormFloat64()*math.Pow(2, float64(rng.Intn(40)))))
+	}
+	return xs
+}
+
+func TestSoftRounding(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name string
+		soft func(float32) float32
+		std  func(float64) float64
+	}{
+		{"ceil", tinymath.CeilSoft, math.Ceil},
+		{"floor", tinymath.FloorSoft, math.Floor},
+		{"trunc", tinymath.TruncSoft, math.Trunc},
+	}
+	xs := archSamples()
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			for _, x := range xs {
+				same(t, c.soft(x), float32(c.std(float64(x))))
+			}
+		})
+	}
+}
+
+func TestSoftSqrt(t *testing.T) {
+	t.Parallel()
+	for _, x := range archSamples() {
+		// Subnormal numbers aren't supported.
+		if x <= 1e-38 || x == tinymath.Inf || tinymath.IsNaN(x) {
+			continue
+		}
+		exp := float32(math.Sqrt(float64(x)))
+		t.Run(fmt.Sprintf("%g", x), func(t *testing.T) {
+			close(t, tinymath.SqrtSoft(x), exp, exp*0.07)
+		})
+	}
+	if !tinymath.IsNaN(tinymath.SqrtSoft(-1)) {
+		t.Fatal("sqrt(-1) must be NaN")
+	}
+}
+
+func TestSoftLeadingZeros(t *testing.T) {
+	t.Parallel()
+	for i := 0; i <= 32; i++ {
+		x := uint32(1<<i - 1)
+		if tinymath.LeadingZerosSoft(x) != uint32(bits.LeadingZeros32(x)) {
+			t.Fatalf("leadingZeros(%#x) = %d", x, tinymath.LeadingZerosSoft(x))
+		}
+	}
+}
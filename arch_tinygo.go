@@ -12,22 +12,36 @@ import (
 	"math/bits"
 )
 
+// f32.ceil
 func Ceil(self float32) float32 {
 	return float32(math.Ceil(float64(self)))
 }
 
+// f32.floor
 func Floor(self float32) float32 {
 	return float32(math.Floor(float64(self)))
 }
 
+// WebAssembly has no fused multiply-add instruction (outside of relaxed SIMD),
+// and llvm.fma would be lowered into a call to the libc fma.
+func FMA(x, y, z float32) float32 {
+	return fmaSoft(x, y, z)
+}
+
+// f32.sqrt
 func Sqrt(self float32) float32 {
 	return float32(math.Sqrt(float64(self)))
 }
 
+// f32.trunc
 func Trunc(self float32) float32 {
 	return float32(math.Trunc(float64(self)))
 }
 
+// f32.sqrt is exact.
+const sqrtExact = true
+
+// i32.clz
 func leadingZeros(x uint32) uint32 {
 	return uint32(bits.LeadingZeros32(x))
 }
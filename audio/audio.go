@@ -0,0 +1,88 @@
+// Package audio converts between units used in audio processing:
+// linear gain and decibels, MIDI notes and frequencies, and cents.
+//
+// Decibel conversions work for any signal, not only for sound.
+//
+// All conversions are built on [tinymath.Exp2] and [tinymath.Log2]
+// and are a few multiplications away from them. In the audible range,
+// the max error is:
+//
+//	| function                         | range              | max error          |
+//	| -------------------------------- | ------------------ | ------------------ |
+//	| GainToDecibels, PowerToDecibels  | [-120, 120] dB     | 1e-4 dB            |
+//	| DecibelsToGain, DecibelsToPower  | [-120, 120] dB     | 3e-6 relative      |
+//	| NoteToFrequency, AddCents        | [0, 135] notes     | 1e-6 relative      |
+//	| FrequencyToNote, Cents           | [8, 20 000] Hz     | 2e-3 cents         |
+//
+// It's much less than humans can hear: about 0.1 dB for loudness and 5 cents for pitch.
+package audio
+
+import "github.com/orsinium-labs/tinymath"
+
+const (
+	// The MIDI note number of A4, the tuning reference.
+	A4 = 69
+	// The frequency of A4 in hertz.
+	A4Frequency float32 = 440
+)
+
+const (
+	// 10*log10(2), decibels in one doubling of the power.
+	//
+	// The power is proportional to the square of the amplitude,
+	// so one doubling of the amplitude is twice as many decibels.
+	decibelsPerDoubling = 3.010_299_956_639_812
+	// log2(10)/10, doublings of the power in one decibel.
+	doublingsPerDecibel = 0.332_192_809_488_736_2
+)
+
+// Converts a linear amplitude gain (like the ratio of two voltages or sample values)
+// into decibels.
+//
+// Returns -Inf for zero gain (silence) and NaN for negative gain.
+func GainToDecibels(gain float32) float32 {
+	return tinymath.Log2(gain) * (2 * decibelsPerDoubling)
+}
+
+// Converts decibels into a linear amplitude gain.
+func DecibelsToGain(db float32) float32 {
+	return tinymath.Exp2(db * (doublingsPerDecibel / 2))
+}
+
+// Converts the ratio of two powers (like watts) into decibels.
+func PowerToDecibels(ratio float32) float32 {
+	return tinymath.Log2(ratio) * decibelsPerDoubling
+}
+
+// Converts decibels into the ratio of two powers.
+func DecibelsToPower(db float32) float32 {
+	return tinymath.Exp2(db * doublingsPerDecibel)
+}
+
+// Converts a MIDI note number into the frequency in hertz using 12-tone equal temperament.
+//
+// The note can be fractional, for example, after a pitch bend.
+func NoteToFrequency(note float32) float32 {
+	return A4Frequency * tinymath.Exp2((note-A4)*(1.0/12))
+}
+
+// Converts a frequency in hertz into a (fractional) MIDI note number,
+// the inverse of [NoteToFrequency].
+//
+// Round the result to get the closest note.
+func FrequencyToNote(freq float32) float32 {
+	return A4 + 12*tinymath.Log2(freq*(1/A4Frequency))
+}
+
+// Returns the interval from one frequency to another in cents.
+//
+// A cent is 1/100 of a semitone, an octave has 1200 cents.
+// The result is negative if `to` is lower than `from`.
+func Cents(from, to float32) float32 {
+	return 1200 * tinymath.Log2(to/from)
+}
+
+// Shifts the frequency by the given number of cents.
+func AddCents(freq, cents float32) float32 {
+	return freq * tinymath.Exp2(cents*(1.0/1200))
+}
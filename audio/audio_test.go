@@ -0,0 +1,71 @@
+package audio_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/orsinium-labs/tinymath"
+	"github.com/orsinium-labs/tinymath/audio"
+)
+
+// Checks that the absolute difference is within the given tolerance.
+func close(t *testing.T, act, exp, eps float64) {
+	t.Helper()
+	if !(math.Abs(act-exp) <= eps) {
+		t.Fatalf("%g != %g (diff %g > %g)", act, exp, act-exp, eps)
+	}
+}
+
+func TestDecibels(t *testing.T) {
+	t.Parallel()
+	for db := -120.0; db <= 120; db += 0.01 {
+		gain := math.Pow(10, db/20)
+		close(t, float64(audio.DecibelsToGain(float32(db))), gain, gain*2e-6)
+		close(t, float64(audio.GainToDecibels(float32(gain))), db, 1e-4)
+		pow := math.Pow(10, db/10)
+		close(t, float64(audio.DecibelsToPower(float32(db))), pow, pow*3e-6)
+		close(t, float64(audio.PowerToDecibels(float32(pow))), db, 1e-4)
+	}
+	close(t, float64(audio.GainToDecibels(1)), 0, 0)
+	close(t, float64(audio.GainToDecibels(2)), 6.0206, 1e-4)
+	close(t, float64(audio.DecibelsToGain(0)), 1, 0)
+	close(t, float64(audio.DecibelsToGain(-6.0206)), 0.5, 1e-5)
+	close(t, float64(audio.PowerToDecibels(2)), 3.0103, 1e-4)
+	close(t, float64(audio.DecibelsToPower(0)), 1, 0)
+	if db := audio.GainToDecibels(0); db != tinymath.NegInf {
+		t.Fatalf("silence: %g", db)
+	}
+}
+
+func TestNotes(t *testing.T) {
+	t.Parallel()
+	for note := 0.0; note <= 135; note += 0.01 {
+		freq := 440 * math.Pow(2, (note-69)/12)
+		close(t, float64(audio.NoteToFrequency(float32(note))), freq, freq*1e-6)
+		close(t, float64(audio.FrequencyToNote(float32(freq))), note, 2e-5)
+	}
+	// The frequencies of all A notes are exact.
+	for note, freq := float32(9), float32(13.75); note < 128; note, freq = note+12, freq*2 {
+		close(t, float64(audio.NoteToFrequency(note)), float64(freq), 0)
+		close(t, float64(audio.FrequencyToNote(freq)), float64(note), 0)
+	}
+	// Middle C.
+	close(t, float64(audio.NoteToFrequency(60)), 261.6256, 1e-4)
+	close(t, float64(audio.FrequencyToNote(261.6256)), 60, 1e-5)
+}
+
+func TestCents(t *testing.T) {
+	t.Parallel()
+	for freq := 8.0; freq <= 20_000; freq *= 1.001 {
+		cents := 1200 * math.Log2(freq/261.63)
+		close(t, float64(audio.Cents(261.63, float32(freq))), cents, 2e-3)
+		for _, c := range []float64{-1200, -37.5, 5, 700, 1200} {
+			exp := freq * math.Pow(2, c/1200)
+			close(t, float64(audio.AddCents(float32(freq), float32(c))), exp, exp*1e-6)
+		}
+	}
+	close(t, float64(audio.Cents(440, 880)), 1200, 0)
+	close(t, float64(audio.Cents(880, 440)), -1200, 0)
+	close(t, float64(audio.Cents(440, 440)), 0, 0)
+	close(t, float64(audio.AddCents(440, 1200)), 880, 0)
+}
@@ -0,0 +1,577 @@
+package tinymath_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/orsinium-labs/tinymath"
+)
+
+// Every function has a "tinymath" sub-benchmark and, if the math package
+// has an equivalent, a "math" sub-benchmark running over the same inputs.
+// Both are called through a function value, so neither gets inlined
+// into the benchmark loop and the call overhead is the same.
+//
+// Use cmd/benchtable to turn the output into a markdown table.
+
+// Input distributions. Each is benchSize values generated with a fixed seed.
+var (
+	// Angles in radians, a few turns around zero.
+	benchAngles = benchUniform(1, -2*math.Pi, 2*math.Pi)
+	// Valid inputs for Asin and Acos.
+	benchUnit = benchUniform(2, -1, 1)
+	// Inputs for easing functions.
+	benchProgress = benchUniform(3, 0, 1)
+	// Exponents that don't overflow float32.
+	benchExps = benchUniform(4, -20, 20)
+	// Positive values of many magnitudes, for logarithms and roots.
+	benchPositive = benchLogUniform(5, 1e-3, 1e3)
+	// Values with a fractional part, for rounding.
+	benchReals = benchUniform(6, -1000, 1000)
+	// A second operand for binary functions.
+	benchReals2 = benchUniform(7, -1000, 1000)
+	// Positive integers, for integer functions.
+	benchInts = benchUniform(8, 1, 1e6)
+	// Bases and exponents for powers.
+	benchBases  = benchLogUniform(9, 0.1, 10)
+	benchPowers = benchUniform(10, -3, 3)
+)
+
+var (
+	benchSink   float32
+	benchSink64 float64
+)
+
+func benchUniform(seed int64, lo, hi float64) []float32 {
+	r := rand.New(rand.NewSource(seed))
+	xs := make([]float32, benchSize)
+	for i := range xs {
+		xs[i] = float32(lo + r.Float64()*(hi-lo))
+	}
+	return xs
+}
+
+func benchLogUniform(seed int64, lo, hi float64) []float32 {
+	xs := benchUniform(seed, math.Log(lo), math.Log(hi))
+	for i, x := range xs {
+		xs[i] = float32(math.Exp(float64(x)))
+	}
+	return xs
+}
+
+func to64(xs []float32) []float64 {
+	res := make([]float64, len(xs))
+	for i, x := range xs {
+		res[i] = float64(x)
+	}
+	return res
+}
+
+// Benchmarks a unary function. `std` can be nil if math has no equivalent.
+func bench1(b *testing.B, xs []float32, tiny func(float32) float32, std func(float64) float64) {
+	b.Run("tinymath", func(b *testing.B) {
+		var s float32
+		for i := 0; i < b.N; i++ {
+			s += tiny(xs[i%benchSize])
+		}
+		benchSink = s
+	})
+	if std == nil {
+		return
+	}
+	xs64 := to64(xs)
+	b.Run("math", func(b *testing.B) {
+		var s float64
+		for i := 0; i < b.N; i++ {
+			s += std(xs64[i%benchSize])
+		}
+		benchSink64 = s
+	})
+}
+
+// Benchmarks a binary function. `std` can be nil if math has no equivalent.
+func bench2(b *testing.B, xs, ys []float32, tiny func(float32, float32) float32, std func(float64, float64) float64) {
+	b.Run("tinymath", func(b *testing.B) {
+		var s float32
+		for i := 0; i < b.N; i++ {
+			j := i % benchSize
+			s += tiny(xs[j], ys[j])
+		}
+		benchSink = s
+	})
+	if std == nil {
+		return
+	}
+	xs64 := to64(xs)
+	ys64 := to64(ys)
+	b.Run("math", func(b *testing.B) {
+		var s float64
+		for i := 0; i < b.N; i++ {
+			j := i % benchSize
+			s += std(xs64[j], ys64[j])
+		}
+		benchSink64 = s
+	})
+}
+
+// Benchmarks a function on integers. The math package has no equivalents.
+func benchInt(b *testing.B, tiny func(int32) int32) {
+	xs := make([]int32, benchSize)
+	for i, x := range benchInts {
+		xs[i] = int32(x)
+	}
+	b.Run("tinymath", func(b *testing.B) {
+		var s int32
+		for i := 0; i < b.N; i++ {
+			s += tiny(xs[i%benchSize])
+		}
+		benchSink = float32(s)
+	})
+}
+
+// Benchmarks a function that reduces a whole slice.
+func benchReduce(b *testing.B, tiny func([]float32) float32) {
+	b.Run("tinymath", func(b *testing.B) {
+		b.SetBytes(benchSize * 4)
+		for i := 0; i < b.N; i++ {
+			benchSink = tiny(benchReals)
+		}
+	})
+}
+
+func b2f(x bool) float32 {
+	if x {
+		return 1
+	}
+	return 0
+}
+
+func b2f64(x bool) float64 {
+	if x {
+		return 1
+	}
+	return 0
+}
+
+// Polynomial coefficients for Horner, Estrin, and friends.
+var benchCoeffs = []float32{1, -0.5, 0.25, -0.125, 0.0625, -0.03125, 0.015625, -0.0078125}
+
+// Trigonometry.
+
+func BenchmarkSin(b *testing.B) { bench1(b, benchAngles, tinymath.Sin, math.Sin) }
+func BenchmarkCos(b *testing.B) { bench1(b, benchAngles, tinymath.Cos, math.Cos) }
+func BenchmarkTan(b *testing.B) { bench1(b, benchAngles, tinymath.Tan, math.Tan) }
+
+func BenchmarkSinCos(b *testing.B) {
+	bench1(b, benchAngles, func(x float32) float32 {
+		s, c := tinymath.SinCos(x)
+		return s + c
+	}, func(x float64) float64 {
+		s, c := math.Sincos(x)
+		return s + c
+	})
+}
+
+func BenchmarkAsin(b *testing.B)     { bench1(b, benchUnit, tinymath.Asin, math.Asin) }
+func BenchmarkAcos(b *testing.B)     { bench1(b, benchUnit, tinymath.Acos, math.Acos) }
+func BenchmarkAtan(b *testing.B)     { bench1(b, benchReals, tinymath.Atan, math.Atan) }
+func BenchmarkAtanNorm(b *testing.B) { bench1(b, benchReals, tinymath.AtanNorm, nil) }
+func BenchmarkAtan2(b *testing.B) {
+	bench2(b, benchReals, benchReals2, tinymath.Atan2, math.Atan2)
+}
+func BenchmarkAtan2Norm(b *testing.B) {
+	bench2(b, benchReals, benchReals2, tinymath.Atan2Norm, nil)
+}
+func BenchmarkSinDeg(b *testing.B) {
+	bench1(b, benchReals, tinymath.SinDeg, func(x float64) float64 {
+		return math.Sin(x * (math.Pi / 180))
+	})
+}
+func BenchmarkCosDeg(b *testing.B) {
+	bench1(b, benchReals, tinymath.CosDeg, func(x float64) float64 {
+		return math.Cos(x * (math.Pi / 180))
+	})
+}
+func BenchmarkSinTurns(b *testing.B) {
+	bench1(b, benchUnit, tinymath.SinTurns, func(x float64) float64 {
+		return math.Sin(x * (2 * math.Pi))
+	})
+}
+func BenchmarkCosTurns(b *testing.B) {
+	bench1(b, benchUnit, tinymath.CosTurns, func(x float64) float64 {
+		return math.Cos(x * (2 * math.Pi))
+	})
+}
+func BenchmarkHypot(b *testing.B) {
+	bench2(b, benchReals, benchReals2, tinymath.Hypot, math.Hypot)
+}
+
+// Angles.
+
+func BenchmarkToDegrees(b *testing.B) { bench1(b, benchAngles, tinymath.ToDegrees, nil) }
+func BenchmarkToRadians(b *testing.B) { bench1(b, benchReals, tinymath.ToRadians, nil) }
+func BenchmarkToTurns(b *testing.B)   { bench1(b, benchAngles, tinymath.ToTurns, nil) }
+func BenchmarkFromTurns(b *testing.B) { bench1(b, benchUnit, tinymath.FromTurns, nil) }
+func BenchmarkWrapTau(b *testing.B)   { bench1(b, benchReals, tinymath.WrapTau, nil) }
+func BenchmarkWrapPi(b *testing.B)    { bench1(b, benchReals, tinymath.WrapPi, nil) }
+func BenchmarkAngleDiff(b *testing.B) {
+	bench2(b, benchAngles, benchReals, tinymath.AngleDiff, nil)
+}
+func BenchmarkAngleLerp(b *testing.B) {
+	bench2(b, benchAngles, benchProgress, func(a, t float32) float32 {
+		return tinymath.AngleLerp(a, 1, t)
+	}, nil)
+}
+
+// Exponents and logarithms.
+
+func BenchmarkExp(b *testing.B)  { bench1(b, benchExps, tinymath.Exp, math.Exp) }
+func BenchmarkExp2(b *testing.B) { bench1(b, benchExps, tinymath.Exp2, math.Exp2) }
+func BenchmarkExpLn2Approx(b *testing.B) {
+	bench1(b, benchExps, func(x float32) float32 {
+		return tinymath.ExpLn2Approx(x, 4)
+	}, math.Exp)
+}
+func BenchmarkExpSmallX(b *testing.B) {
+	bench1(b, benchProgress, func(x float32) float32 {
+		return tinymath.ExpSmallX(x, 4)
+	}, math.Exp)
+}
+func BenchmarkLn(b *testing.B)    { bench1(b, benchPositive, tinymath.Ln, math.Log) }
+func BenchmarkLog2(b *testing.B)  { bench1(b, benchPositive, tinymath.Log2, math.Log2) }
+func BenchmarkLog10(b *testing.B) { bench1(b, benchPositive, tinymath.Log10, math.Log10) }
+func BenchmarkLog(b *testing.B) {
+	bench2(b, benchPositive, benchBases, tinymath.Log, func(x, base float64) float64 {
+		return math.Log(x) / math.Log(base)
+	})
+}
+func BenchmarkPowF(b *testing.B) {
+	bench2(b, benchBases, benchPowers, tinymath.PowF, math.Pow)
+}
+func BenchmarkPowI(b *testing.B) {
+	bench2(b, benchBases, benchPowers, func(x, n float32) float32 {
+		return tinymath.PowI(x, int32(n*3))
+	}, func(x, n float64) float64 {
+		return math.Pow(x, float64(int32(n*3)))
+	})
+}
+func BenchmarkSqrt(b *testing.B) { bench1(b, benchPositive, tinymath.Sqrt, math.Sqrt) }
+func BenchmarkInvSqrt(b *testing.B) {
+	bench1(b, benchPositive, tinymath.InvSqrt, func(x float64) float64 {
+		return 1 / math.Sqrt(x)
+	})
+}
+func BenchmarkInv(b *testing.B) {
+	bench1(b, benchPositive, tinymath.Inv, func(x float64) float64 { return 1 / x })
+}
+func BenchmarkRecip(b *testing.B) {
+	bench1(b, benchPositive, tinymath.Recip, func(x float64) float64 { return 1 / x })
+}
+
+// Rounding.
+
+func BenchmarkFloor(b *testing.B) { bench1(b, benchReals, tinymath.Floor, math.Floor) }
+func BenchmarkCeil(b *testing.B)  { bench1(b, benchReals, tinymath.Ceil, math.Ceil) }
+func BenchmarkTrunc(b *testing.B) { bench1(b, benchReals, tinymath.Trunc, math.Trunc) }
+func BenchmarkRound(b *testing.B) { bench1(b, benchReals, tinymath.Round, math.Round) }
+func BenchmarkRoundToEven(b *testing.B) {
+	bench1(b, benchReals, tinymath.RoundToEven, math.RoundToEven)
+}
+func BenchmarkRoundStochastic(b *testing.B) {
+	bench2(b, benchReals, benchProgress, tinymath.RoundStochastic, nil)
+}
+func BenchmarkFract(b *testing.B) {
+	bench1(b, benchReals, tinymath.Fract, func(x float64) float64 {
+		_, f := math.Modf(x)
+		return f
+	})
+}
+func BenchmarkToInt32Sat(b *testing.B) {
+	bench1(b, benchReals, func(x float32) float32 {
+		return float32(tinymath.ToInt32Sat(x))
+	}, nil)
+}
+func BenchmarkToUint32Sat(b *testing.B) {
+	bench1(b, benchReals, func(x float32) float32 {
+		return float32(tinymath.ToUint32Sat(x))
+	}, nil)
+}
+func BenchmarkToInt16Sat(b *testing.B) {
+	bench1(b, benchReals, func(x float32) float32 {
+		return float32(tinymath.ToInt16Sat(x))
+	}, nil)
+}
+func BenchmarkToUint16Sat(b *testing.B) {
+	bench1(b, benchReals, func(x float32) float32 {
+		return float32(tinymath.ToUint16Sat(x))
+	}, nil)
+}
+func BenchmarkToInt8Sat(b *testing.B) {
+	bench1(b, benchReals, func(x float32) float32 {
+		return float32(tinymath.ToInt8Sat(x))
+	}, nil)
+}
+func BenchmarkToUint8Sat(b *testing.B) {
+	bench1(b, benchReals, func(x float32) float32 {
+		return float32(tinymath.ToUint8Sat(x))
+	}, nil)
+}
+
+// Sign and bits.
+
+func BenchmarkAbs(b *testing.B) { bench1(b, benchReals, tinymath.Abs, math.Abs) }
+func BenchmarkCopySign(b *testing.B) {
+	bench2(b, benchReals, benchReals2, tinymath.CopySign, math.Copysign)
+}
+func BenchmarkSign(b *testing.B) { bench1(b, benchReals, tinymath.Sign, nil) }
+func BenchmarkIsNaN(b *testing.B) {
+	bench1(b, benchReals, func(x float32) float32 {
+		return b2f(tinymath.IsNaN(x))
+	}, func(x float64) float64 {
+		return b2f64(math.IsNaN(x))
+	})
+}
+func BenchmarkIsSignPositive(b *testing.B) {
+	bench1(b, benchReals, func(x float32) float32 {
+		return b2f(tinymath.IsSignPositive(x))
+	}, func(x float64) float64 {
+		return b2f64(!math.Signbit(x))
+	})
+}
+func BenchmarkIsInteger(b *testing.B) {
+	bench1(b, benchReals, func(x float32) float32 {
+		return b2f(tinymath.IsInteger(x))
+	}, nil)
+}
+func BenchmarkIsEven(b *testing.B) {
+	bench1(b, benchReals, func(x float32) float32 {
+		return b2f(tinymath.IsEven(x))
+	}, nil)
+}
+func BenchmarkToBits(b *testing.B) {
+	bench1(b, benchReals, func(x float32) float32 {
+		return float32(tinymath.ToBits(x))
+	}, func(x float64) float64 {
+		return float64(math.Float32bits(float32(x)))
+	})
+}
+func BenchmarkFromBits(b *testing.B) {
+	bench1(b, benchInts, func(x float32) float32 {
+		return tinymath.FromBits(uint32(x))
+	}, func(x float64) float64 {
+		return float64(math.Float32frombits(uint32(x)))
+	})
+}
+
+// Decomposition.
+
+func BenchmarkFrexp(b *testing.B) {
+	bench1(b, benchReals, func(x float32) float32 {
+		f, e := tinymath.Frexp(x)
+		return f + float32(e)
+	}, func(x float64) float64 {
+		f, e := math.Frexp(x)
+		return f + float64(e)
+	})
+}
+func BenchmarkLdexp(b *testing.B) {
+	bench2(b, benchReals, benchPowers, func(x, e float32) float32 {
+		return tinymath.Ldexp(x, int32(e*10))
+	}, func(x, e float64) float64 {
+		return math.Ldexp(x, int(int32(e*10)))
+	})
+}
+func BenchmarkModf(b *testing.B) {
+	bench1(b, benchReals, func(x float32) float32 {
+		i, f := tinymath.Modf(x)
+		return i + f
+	}, func(x float64) float64 {
+		i, f := math.Modf(x)
+		return i + f
+	})
+}
+func BenchmarkIlogb(b *testing.B) {
+	bench1(b, benchReals, func(x float32) float32 {
+		return float32(tinymath.Ilogb(x))
+	}, func(x float64) float64 {
+		return float64(math.Ilogb(x))
+	})
+}
+func BenchmarkLogb(b *testing.B) { bench1(b, benchReals, tinymath.Logb, math.Logb) }
+func BenchmarkNextafter(b *testing.B) {
+	bench2(b, benchReals, benchReals2, tinymath.Nextafter, math.Nextafter)
+}
+func BenchmarkNextup(b *testing.B) {
+	bench1(b, benchReals, tinymath.Nextup, func(x float64) float64 {
+		return math.Nextafter(x, math.Inf(1))
+	})
+}
+func BenchmarkNextdown(b *testing.B) {
+	bench1(b, benchReals, tinymath.Nextdown, func(x float64) float64 {
+		return math.Nextafter(x, math.Inf(-1))
+	})
+}
+
+// Arithmetic.
+
+func BenchmarkFMA(b *testing.B) {
+	bench2(b, benchReals, benchReals2, func(x, y float32) float32 {
+		return tinymath.FMA(x, y, 1)
+	}, func(x, y float64) float64 {
+		return math.FMA(x, y, 1)
+	})
+}
+func BenchmarkDivEuclid(b *testing.B) {
+	bench2(b, benchReals, benchReals2, tinymath.DivEuclid, nil)
+}
+func BenchmarkRemEuclid(b *testing.B) {
+	bench2(b, benchReals, benchReals2, tinymath.RemEuclid, nil)
+}
+func BenchmarkMax(b *testing.B) {
+	bench2(b, benchReals, benchReals2, tinymath.Max[float32], math.Max)
+}
+func BenchmarkMin(b *testing.B) {
+	bench2(b, benchReals, benchReals2, tinymath.Min[float32], math.Min)
+}
+func BenchmarkClamp(b *testing.B) {
+	bench1(b, benchReals, func(x float32) float32 {
+		return tinymath.Clamp(x, -100, 100)
+	}, nil)
+}
+
+// Interpolation.
+
+func BenchmarkLerp(b *testing.B) {
+	bench2(b, benchReals, benchProgress, func(x, t float32) float32 {
+		return tinymath.Lerp(x, 100, t)
+	}, nil)
+}
+func BenchmarkInverseLerp(b *testing.B) {
+	bench1(b, benchReals, func(x float32) float32 {
+		return tinymath.InverseLerp(-100, 100, x)
+	}, nil)
+}
+func BenchmarkRemap(b *testing.B) {
+	bench1(b, benchReals, func(x float32) float32 {
+		return tinymath.Remap(x, -1000, 1000, 0, 1)
+	}, nil)
+}
+func BenchmarkSmoothstep(b *testing.B) {
+	bench1(b, benchReals, func(x float32) float32 {
+		return tinymath.Smoothstep(-100, 100, x)
+	}, nil)
+}
+func BenchmarkSmootherstep(b *testing.B) {
+	bench1(b, benchReals, func(x float32) float32 {
+		return tinymath.Smootherstep(-100, 100, x)
+	}, nil)
+}
+
+// Easing.
+
+func BenchmarkEaseInQuad(b *testing.B)     { bench1(b, benchProgress, tinymath.EaseInQuad, nil) }
+func BenchmarkEaseOutQuad(b *testing.B)    { bench1(b, benchProgress, tinymath.EaseOutQuad, nil) }
+func BenchmarkEaseInOutQuad(b *testing.B)  { bench1(b, benchProgress, tinymath.EaseInOutQuad, nil) }
+func BenchmarkEaseInCubic(b *testing.B)    { bench1(b, benchProgress, tinymath.EaseInCubic, nil) }
+func BenchmarkEaseOutCubic(b *testing.B)   { bench1(b, benchProgress, tinymath.EaseOutCubic, nil) }
+func BenchmarkEaseInOutCubic(b *testing.B) { bench1(b, benchProgress, tinymath.EaseInOutCubic, nil) }
+func BenchmarkEaseInQuart(b *testing.B)    { bench1(b, benchProgress, tinymath.EaseInQuart, nil) }
+func BenchmarkEaseOutQuart(b *testing.B)   { bench1(b, benchProgress, tinymath.EaseOutQuart, nil) }
+func BenchmarkEaseInOutQuart(b *testing.B) { bench1(b, benchProgress, tinymath.EaseInOutQuart, nil) }
+func BenchmarkEaseInQuint(b *testing.B)    { bench1(b, benchProgress, tinymath.EaseInQuint, nil) }
+func BenchmarkEaseOutQuint(b *testing.B)   { bench1(b, benchProgress, tinymath.EaseOutQuint, nil) }
+func BenchmarkEaseInOutQuint(b *testing.B) { bench1(b, benchProgress, tinymath.EaseInOutQuint, nil) }
+func BenchmarkEaseInSine(b *testing.B)     { bench1(b, benchProgress, tinymath.EaseInSine, nil) }
+func BenchmarkEaseOutSine(b *testing.B)    { bench1(b, benchProgress, tinymath.EaseOutSine, nil) }
+func BenchmarkEaseInOutSine(b *testing.B)  { bench1(b, benchProgress, tinymath.EaseInOutSine, nil) }
+func BenchmarkEaseInExpo(b *testing.B)     { bench1(b, benchProgress, tinymath.EaseInExpo, nil) }
+func BenchmarkEaseOutExpo(b *testing.B)    { bench1(b, benchProgress, tinymath.EaseOutExpo, nil) }
+func BenchmarkEaseInOutExpo(b *testing.B)  { bench1(b, benchProgress, tinymath.EaseInOutExpo, nil) }
+func BenchmarkEaseInCirc(b *testing.B)     { bench1(b, benchProgress, tinymath.EaseInCirc, nil) }
+func BenchmarkEaseOutCirc(b *testing.B)    { bench1(b, benchProgress, tinymath.EaseOutCirc, nil) }
+func BenchmarkEaseInOutCirc(b *testing.B)  { bench1(b, benchProgress, tinymath.EaseInOutCirc, nil) }
+func BenchmarkEaseInBack(b *testing.B)     { bench1(b, benchProgress, tinymath.EaseInBack, nil) }
+func BenchmarkEaseOutBack(b *testing.B)    { bench1(b, benchProgress, tinymath.EaseOutBack, nil) }
+func BenchmarkEaseInOutBack(b *testing.B)  { bench1(b, benchProgress, tinymath.EaseInOutBack, nil) }
+func BenchmarkEaseInElastic(b *testing.B)  { bench1(b, benchProgress, tinymath.EaseInElastic, nil) }
+func BenchmarkEaseOutElastic(b *testing.B) { bench1(b, benchProgress, tinymath.EaseOutElastic, nil) }
+func BenchmarkEaseInOutElastic(b *testing.B) {
+	bench1(b, benchProgress, tinymath.EaseInOutElastic, nil)
+}
+func BenchmarkEaseInBounce(b *testing.B)    { bench1(b, benchProgress, tinymath.EaseInBounce, nil) }
+func BenchmarkEaseOutBounce(b *testing.B)   { bench1(b, benchProgress, tinymath.EaseOutBounce, nil) }
+func BenchmarkEaseInOutBounce(b *testing.B) { bench1(b, benchProgress, tinymath.EaseInOutBounce, nil) }
+
+// Polynomials.
+
+func BenchmarkHorner(b *testing.B) {
+	bench1(b, benchUnit, func(x float32) float32 {
+		return tinymath.Horner(x, benchCoeffs)
+	}, nil)
+}
+func BenchmarkEstrin(b *testing.B) {
+	bench1(b, benchUnit, func(x float32) float32 {
+		return tinymath.Estrin(x, benchCoeffs)
+	}, nil)
+}
+func BenchmarkRational(b *testing.B) {
+	bench1(b, benchUnit, func(x float32) float32 {
+		return tinymath.Rational(x, benchCoeffs[:4], benchCoeffs[4:])
+	}, nil)
+}
+func BenchmarkChebyshev(b *testing.B) {
+	bench1(b, benchUnit, func(x float32) float32 {
+		return tinymath.Chebyshev(x, benchCoeffs)
+	}, nil)
+}
+
+// Sums.
+
+func BenchmarkKahanSum(b *testing.B)    { benchReduce(b, tinymath.KahanSum) }
+func BenchmarkNeumaierSum(b *testing.B) { benchReduce(b, tinymath.NeumaierSum) }
+func BenchmarkPairwiseSum(b *testing.B) { benchReduce(b, tinymath.PairwiseSum) }
+func BenchmarkDotCompensated(b *testing.B) {
+	benchReduce(b, func(xs []float32) float32 {
+		return tinymath.DotCompensated(xs, benchReals2)
+	})
+}
+func BenchmarkAccumulator(b *testing.B) {
+	benchReduce(b, func(xs []float32) float32 {
+		var acc tinymath.Accumulator
+		for _, x := range xs {
+			acc.Add(x)
+		}
+		return acc.Sum()
+	})
+}
+
+// Integers.
+
+func BenchmarkSaturatingAdd(b *testing.B) {
+	benchInt(b, func(x int32) int32 { return tinymath.SaturatingAdd(x, 1<<30) })
+}
+func BenchmarkSaturatingSub(b *testing.B) {
+	benchInt(b, func(x int32) int32 { return tinymath.SaturatingSub(-x, 1<<30) })
+}
+func BenchmarkSaturatingMul(b *testing.B) {
+	benchInt(b, func(x int32) int32 { return tinymath.SaturatingMul(x, x) })
+}
+func BenchmarkIPow(b *testing.B) {
+	benchInt(b, func(x int32) int32 {
+		r, _ := tinymath.IPow(x%100, 4)
+		return r
+	})
+}
+func BenchmarkISqrt(b *testing.B)    { benchInt(b, tinymath.ISqrt[int32]) }
+func BenchmarkILog2(b *testing.B)    { benchInt(b, tinymath.ILog2[int32]) }
+func BenchmarkCeilLog2(b *testing.B) { benchInt(b, tinymath.CeilLog2[int32]) }
+func BenchmarkGCD(b *testing.B) {
+	benchInt(b, func(x int32) int32 { return tinymath.GCD(x, 360360) })
+}
+func BenchmarkLCM(b *testing.B) {
+	benchInt(b, func(x int32) int32 {
+		r, _ := tinymath.LCM(x%1000, 360)
+		return r
+	})
+}
@@ -0,0 +1,162 @@
+// Command benchtable turns the output of `go test -bench` for tinymath
+// into a markdown table comparing each function with the math package.
+//
+// Every benchmark must have a "tinymath" sub-benchmark and can have a "math" one:
+//
+//	go test -run '^$' -bench . -count 5 | go run ./cmd/benchtable -paired
+//
+// If a benchmark ran several times (with -count), the median is used.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+// The time per call of one function.
+type result struct {
+	Function string
+	// Nanoseconds per call of the tinymath function.
+	Tinymath float64
+	// Nanoseconds per call of the matching function from the math package, zero if there is none.
+	Stdlib float64
+}
+
+// Matches lines like "BenchmarkSin/tinymath-8   1000000   12.3 ns/op".
+// The optional suffix after the dash is GOMAXPROCS.
+var benchRe = regexp.MustCompile(`^Benchmark(\w+)/(tinymath|math)(?:-\d+)?\s+\d+\s+([\d.]+) ns/op`)
+
+func main() {
+	var in string
+	var paired bool
+	flag.StringVar(&in, "in", "", "the file with the benchmark output, stdin if empty")
+	flag.BoolVar(&paired, "paired", false, "only show functions that have a match in the math package")
+	flag.Parse()
+
+	var r io.Reader = os.Stdin
+	if in != "" {
+		f, err := os.Open(in)
+		if err != nil {
+			fail(err)
+		}
+		defer f.Close()
+		r = f
+	}
+	results, err := parse(r)
+	if err != nil {
+		fail(err)
+	}
+	if paired {
+		results = onlyPaired(results)
+	}
+	if len(results) == 0 {
+		fail(fmt.Errorf("no tinymath benchmarks found in the input"))
+	}
+	writeMarkdown(os.Stdout, results)
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
+
+// Reads benchmark results in the order they first appear in the output.
+//
+// Lines that aren't benchmark results are ignored.
+func parse(r io.Reader) ([]result, error) {
+	var names []string
+	samples := make(map[string]map[string][]float64)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		m := benchRe.FindStringSubmatch(scanner.Text())
+		if m == nil {
+			continue
+		}
+		name, variant := m[1], m[2]
+		ns, err := strconv.ParseFloat(m[3], 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse %q: %w", scanner.Text(), err)
+		}
+		if samples[name] == nil {
+			names = append(names, name)
+			samples[name] = make(map[string][]float64)
+		}
+		samples[name][variant] = append(samples[name][variant], ns)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	results := make([]result, 0, len(names))
+	for _, name := range names {
+		s := samples[name]
+		if len(s["tinymath"]) == 0 {
+			continue
+		}
+		results = append(results, result{
+			Function: name,
+			Tinymath: median(s["tinymath"]),
+			Stdlib:   median(s["math"]),
+		})
+	}
+	return results, nil
+}
+
+func onlyPaired(results []result) []result {
+	res := make([]result, 0, len(results))
+	for _, r := range results {
+		if r.Stdlib > 0 {
+			res = append(res, r)
+		}
+	}
+	return res
+}
+
+// Returns the median of the values or zero if there are none.
+func median(xs []float64) float64 {
+	if len(xs) == 0 {
+		return 0
+	}
+	sorted := append([]float64(nil), xs...)
+	sort.Float64s(sorted)
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
+
+// Writes the table in the same layout as the size table in the README.
+//
+// The times are in nanoseconds per call. The speedup is how many times
+// the tinymath function is faster than the math one.
+func writeMarkdown(w io.Writer, results []result) {
+	fmt.Fprintln(w, "| function     | tinymath | stdlib | speedup |")
+	fmt.Fprintln(w, "| ------------ | --------:| ------:| -------:|")
+	for _, r := range results {
+		std, speedup := "", ""
+		if r.Stdlib > 0 {
+			std = formatNs(r.Stdlib)
+			speedup = fmt.Sprintf("%.1fx", r.Stdlib/r.Tinymath)
+		}
+		fmt.Fprintf(w, "| %-12s | %8s | %6s | %7s |\n", r.Function, formatNs(r.Tinymath), std, speedup)
+	}
+}
+
+// Formats nanoseconds with 3 significant digits, like `go test` does for small values.
+func formatNs(ns float64) string {
+	switch {
+	case ns >= 100:
+		return strconv.FormatFloat(ns, 'f', 0, 64)
+	case ns >= 10:
+		return strconv.FormatFloat(ns, 'f', 1, 64)
+	default:
+		return strconv.FormatFloat(ns, 'f', 2, 64)
+	}
+}
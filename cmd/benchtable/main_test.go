@@ -0,0 +1,87 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+const output = `goos: linux
+goarch: amd64
+pkg: github.com/orsinium-labs/tinymath
+cpu: Some CPU
+BenchmarkSin/tinymath-8         	100000000	         4.000 ns/op
+BenchmarkSin/math-8             	100000000	        10.00 ns/op
+BenchmarkSin/tinymath-8         	100000000	         2.000 ns/op
+BenchmarkSin/math-8             	100000000	        12.00 ns/op
+BenchmarkSin/tinymath-8         	100000000	         3.000 ns/op
+BenchmarkSin/math-8             	100000000	        11.00 ns/op
+BenchmarkRecip/tinymath         	100000000	         1.234 ns/op
+BenchmarkKahanSum/tinymath-8    	    2000	      2104 ns/op	1946.90 MB/s
+BenchmarkSinSlice-8             	    2000	     57784 ns/op	  70.88 MB/s
+PASS
+ok  	github.com/orsinium-labs/tinymath	12.345s
+`
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	results, err := parse(strings.NewReader(output))
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []result{
+		{Function: "Sin", Tinymath: 3, Stdlib: 11},
+		{Function: "Recip", Tinymath: 1.234},
+		{Function: "KahanSum", Tinymath: 2104},
+	}
+	if len(results) != len(exp) {
+		t.Fatalf("%v", results)
+	}
+	for i := range exp {
+		if results[i] != exp[i] {
+			t.Fatalf("%v != %v", results[i], exp[i])
+		}
+	}
+	paired := onlyPaired(results)
+	if len(paired) != 1 || paired[0].Function != "Sin" {
+		t.Fatalf("%v", paired)
+	}
+}
+
+func TestMedian(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		given []float64
+		exp   float64
+	}{
+		{nil, 0},
+		{[]float64{5}, 5},
+		{[]float64{3, 1, 2}, 2},
+		{[]float64{4, 1, 3, 2}, 2.5},
+	}
+	for _, c := range cases {
+		if act := median(c.given); act != c.exp {
+			t.Fatalf("median(%v) = %v, want %v", c.given, act, c.exp)
+		}
+	}
+}
+
+func TestWriteMarkdown(t *testing.T) {
+	t.Parallel()
+	results := []result{
+		{Function: "Sin", Tinymath: 3.1, Stdlib: 12.4},
+		{Function: "KahanSum", Tinymath: 2104},
+		{Function: "Sqrt", Tinymath: 1.5, Stdlib: 1},
+	}
+	var buf bytes.Buffer
+	writeMarkdown(&buf, results)
+	exp := `| function     | tinymath | stdlib | speedup |
+| ------------ | --------:| ------:| -------:|
+| Sin          |     3.10 |   12.4 |    4.0x |
+| KahanSum     |     2104 |        |         |
+| Sqrt         |     1.50 |   1.00 |    0.7x |
+`
+	if buf.String() != exp {
+		t.Fatalf("\n%s", buf.String())
+	}
+}
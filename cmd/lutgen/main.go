@@ -0,0 +1,86 @@
+// Command lutgen generates Go source code with a sine table for the lut package.
+//
+// Use it with go generate:
+//
+//	//go:generate go run github.com/orsinium-labs/tinymath/cmd/lutgen -size 256 -pkg main -name sinTable -out sin_table.go
+//
+// And then load the table:
+//
+//	var table = lut.Load(sinTable[:])
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"os"
+
+	"github.com/orsinium-labs/tinymath/lut"
+)
+
+type config struct {
+	size int
+	pkg  string
+	name string
+}
+
+func main() {
+	var c config
+	var out string
+	flag.IntVar(&c.size, "size", 256, "the number of intervals in the table, a power of two")
+	flag.StringVar(&c.pkg, "pkg", "main", "the package name of the generated file")
+	flag.StringVar(&c.name, "name", "sinTable", "the variable name of the table")
+	flag.StringVar(&out, "out", "", "the output file path, stdout if empty")
+	flag.Parse()
+
+	var w io.Writer = os.Stdout
+	if out != "" {
+		f, err := os.Create(out)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		w = f
+	}
+	if err := generate(w, c); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func generate(w io.Writer, c config) error {
+	if c.size < 4 || c.size&(c.size-1) != 0 {
+		return fmt.Errorf("the size must be a power of two and at least 4, got %d", c.size)
+	}
+	values := make([]float32, c.size+1)
+	lut.New(values)
+
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated by lutgen. DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintf(&buf, "package %s\n\n", c.pkg)
+	fmt.Fprintf(&buf, "// Sine values for one full turn in %d intervals.\n", c.size)
+	fmt.Fprintf(&buf, "var %s = [%d]float32{\n", c.name, len(values))
+	for i, v := range values {
+		if i%4 == 0 {
+			buf.WriteString("\t")
+		}
+		fmt.Fprintf(&buf, "%#v,", v)
+		if i%4 == 3 || i == len(values)-1 {
+			buf.WriteString("\n")
+		} else {
+			buf.WriteString(" ")
+		}
+	}
+	fmt.Fprintln(&buf, "}")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(src)
+	return err
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/orsinium-labs/tinymath/lut"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	err := generate(&buf, config{size: 16, pkg: "synth", name: "table"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := buf.String()
+	if !strings.HasPrefix(src, "// Code generated by lutgen. DO NOT EDIT.") {
+		t.Fatalf("no header:\n%s", src)
+	}
+
+	f, err := parser.ParseFile(token.NewFileSet(), "table.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.Name.Name != "synth" {
+		t.Fatalf("package: %s", f.Name.Name)
+	}
+	if f.Scope.Lookup("table") == nil {
+		t.Fatal("no table variable")
+	}
+
+	// The generated values must be exactly the same as the ones from lut.New.
+	exp := make([]float32, 17)
+	lut.New(exp)
+	var act []float32
+	ast.Inspect(f, func(n ast.Node) bool {
+		lit, ok := n.(*ast.CompositeLit)
+		if !ok {
+			return true
+		}
+		for _, elt := range lit.Elts {
+			act = append(act, parseFloat(t, elt))
+		}
+		return false
+	})
+	if len(act) != len(exp) {
+		t.Fatalf("%d values, expected %d", len(act), len(exp))
+	}
+	for i := range exp {
+		if act[i] != exp[i] {
+			t.Fatalf("value %d: %v != %v", i, act[i], exp[i])
+		}
+	}
+}
+
+func TestGenerateInvalidSize(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	err := generate(&buf, config{size: 100, pkg: "main", name: "table"})
+	if err == nil {
+		t.Fatal("no error")
+	}
+}
+
+// Parses a float literal, possibly negated.
+func parseFloat(t *testing.T, expr ast.Expr) float32 {
+	t.Helper()
+	sign := float32(1)
+	if u, ok := expr.(*ast.UnaryExpr); ok && u.Op == token.SUB {
+		sign = -1
+		expr = u.X
+	}
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok {
+		t.Fatalf("not a literal: %#v", expr)
+	}
+	v, err := strconv.ParseFloat(lit.Value, 32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sign * float32(v)
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"sort"
+)
+
+// An exported function of tinymath that can be benchmarked.
+type function struct {
+	Name    string
+	Params  []string
+	Results []string
+	// The name of the matching function in the math package, if any.
+	Std string
+}
+
+// Names of functions in the math package that differ from tinymath.
+var stdAliases = map[string]string{
+	"Ln":       "Log",
+	"PowF":     "Pow",
+	"CopySign": "Copysign",
+	"SinCos":   "Sincos",
+}
+
+// Types that wrapper programs know how to pass in and out.
+var supportedTypes = map[string]bool{
+	"float32": true,
+	"float64": true,
+	"int32":   true,
+	"uint32":  true,
+	"int64":   true,
+	"uint64":  true,
+	"int":     true,
+	"bool":    true,
+}
+
+// Collects exported functions from the package in the directory.
+//
+// Only files for the current platform are parsed, so that functions
+// defined in several arch_*.go files aren't duplicated.
+// Generic functions and functions with unsupported types are skipped.
+func collect(dir string) ([]function, error) {
+	pkg, err := build.Default.ImportDir(dir, 0)
+	if err != nil {
+		return nil, err
+	}
+	std, err := stdFunctions()
+	if err != nil {
+		return nil, err
+	}
+	fset := token.NewFileSet()
+	var funcs []function
+	for _, name := range pkg.GoFiles {
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range file.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || fd.Recv != nil || !fd.Name.IsExported() || fd.Type.TypeParams != nil {
+				continue
+			}
+			f := function{Name: fd.Name.Name}
+			f.Params, ok = fieldTypes(fd.Type.Params)
+			if !ok {
+				continue
+			}
+			f.Results, ok = fieldTypes(fd.Type.Results)
+			if !ok || len(f.Results) == 0 {
+				continue
+			}
+			f.Std = matchStd(f, std)
+			funcs = append(funcs, f)
+		}
+	}
+	sort.Slice(funcs, func(i, j int) bool { return funcs[i].Name < funcs[j].Name })
+	return funcs, nil
+}
+
+// Returns the types of all fields, one per value.
+//
+// The second return value is false if any of the types isn't supported.
+func fieldTypes(fields *ast.FieldList) ([]string, bool) {
+	if fields == nil {
+		return nil, true
+	}
+	var res []string
+	for _, field := range fields.List {
+		ident, ok := field.Type.(*ast.Ident)
+		if !ok || !supportedTypes[ident.Name] {
+			return nil, false
+		}
+		n := max(len(field.Names), 1)
+		for i := 0; i < n; i++ {
+			res = append(res, ident.Name)
+		}
+	}
+	return res, true
+}
+
+// Returns signatures of all exported functions of the math package.
+func stdFunctions() (map[string]*types.Signature, error) {
+	pkg, err := importer.ForCompiler(token.NewFileSet(), "source", nil).Import("math")
+	if err != nil {
+		return nil, fmt.Errorf("import math: %w", err)
+	}
+	res := make(map[string]*types.Signature)
+	scope := pkg.Scope()
+	for _, name := range scope.Names() {
+		if fn, ok := scope.Lookup(name).(*types.Func); ok && fn.Exported() {
+			res[name] = fn.Type().(*types.Signature)
+		}
+	}
+	return res, nil
+}
+
+// Finds the function in the math package with the same name and a matching signature.
+//
+// The signatures match if they are the same after replacing float32 with float64
+// and int32 with int. Returns an empty string if there is no such function.
+func matchStd(f function, std map[string]*types.Signature) string {
+	name := f.Name
+	if alias, ok := stdAliases[name]; ok {
+		name = alias
+	}
+	sig, ok := std[name]
+	if !ok {
+		return ""
+	}
+	if !sameTypes(f.Params, sig.Params()) || !sameTypes(f.Results, sig.Results()) {
+		return ""
+	}
+	return name
+}
+
+func sameTypes(tiny []string, std *types.Tuple) bool {
+	if len(tiny) != std.Len() {
+		return false
+	}
+	for i, t := range tiny {
+		if stdType(t) != std.At(i).Type().String() {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns the type used by the math package in place of the tinymath type.
+func stdType(t string) string {
+	switch t {
+	case "float32":
+		return "float64"
+	case "int32":
+		return "int"
+	}
+	return t
+}
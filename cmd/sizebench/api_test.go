@@ -0,0 +1,52 @@
+package main
+
+import "testing"
+
+func TestCollect(t *testing.T) {
+	t.Parallel()
+	funcs, err := collect("../..")
+	if err != nil {
+		t.Fatal(err)
+	}
+	byName := make(map[string]function)
+	for _, f := range funcs {
+		if _, ok := byName[f.Name]; ok {
+			t.Fatalf("%s is duplicated", f.Name)
+		}
+		byName[f.Name] = f
+	}
+
+	cases := []struct {
+		name string
+		std  string
+	}{
+		{"Sin", "Sin"},
+		{"SinCos", "Sincos"},
+		{"Ln", "Log"},
+		{"PowF", "Pow"},
+		{"Frexp", "Frexp"},
+		{"Ilogb", "Ilogb"},
+		{"Trunc", "Trunc"},
+		// No std counterpart.
+		{"Recip", ""},
+		{"EaseInQuad", ""},
+		// math.Log has a different signature.
+		{"Log", ""},
+	}
+	for _, c := range cases {
+		f, ok := byName[c.name]
+		if !ok {
+			t.Fatalf("%s not found", c.name)
+		}
+		if f.Std != c.std {
+			t.Fatalf("%s: std is %q, expected %q", c.name, f.Std, c.std)
+		}
+	}
+
+	// Generic functions, functions with slices, and unexported functions are skipped.
+	for _, name := range []string{"Max", "Clamp", "ISqrt", "SinSlice", "Horner", "cosTurns"} {
+		if _, ok := byName[name]; ok {
+			t.Fatalf("%s must be skipped", name)
+		}
+	}
+}
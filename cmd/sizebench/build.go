@@ -0,0 +1,173 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+)
+
+// Builds the program in the directory for the target and returns its size in bytes.
+type builder func(dir, target string) (int, error)
+
+// Generates the source code of a program that calls the function.
+//
+// Arguments are loaded and results are stored with volatile operations,
+// so that the compiler can't evaluate the function at compile time
+// or remove it as unused, on any target.
+func wrapper(f function, std bool) ([]byte, error) {
+	pkg, name := "tinymath", f.Name
+	params, results := f.Params, f.Results
+	if std {
+		pkg, name = "math", f.Std
+		params = mapTypes(params, stdType)
+		results = mapTypes(results, stdType)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by sizebench. DO NOT EDIT.\n\n")
+	buf.WriteString("package main\n\n")
+	buf.WriteString("import (\n")
+	if std || needsMath(params) || needsMath(results) {
+		buf.WriteString("\t\"math\"\n")
+	}
+	buf.WriteString("\t\"runtime/volatile\"\n")
+	if !std {
+		buf.WriteString("\n\t\"github.com/orsinium-labs/tinymath\"\n")
+	}
+	buf.WriteString(")\n\n")
+	for i, t := range params {
+		fmt.Fprintf(&buf, "var in%d %s\n", i, storage(t))
+	}
+	for i, t := range results {
+		fmt.Fprintf(&buf, "var out%d %s\n", i, storage(t))
+	}
+	buf.WriteString("\nfunc main() {\n\t")
+	for i := range results {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		fmt.Fprintf(&buf, "r%d", i)
+	}
+	fmt.Fprintf(&buf, " := %s.%s(", pkg, name)
+	for i, t := range params {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(load(t, fmt.Sprintf("in%d", i)))
+	}
+	buf.WriteString(")\n")
+	for i, t := range results {
+		fmt.Fprintf(&buf, "\t%s\n", store(t, fmt.Sprintf("out%d", i), fmt.Sprintf("r%d", i)))
+	}
+	buf.WriteString("}\n")
+	return format.Source(buf.Bytes())
+}
+
+// The source code of the program that the size of every wrapper is compared to.
+const baseline = "package main\n\nfunc main() {}\n"
+
+func mapTypes(ts []string, f func(string) string) []string {
+	res := make([]string, len(ts))
+	for i, t := range ts {
+		res[i] = f(t)
+	}
+	return res
+}
+
+// Checks if the math package is needed to convert values of the types.
+func needsMath(ts []string) bool {
+	for _, t := range ts {
+		if t == "float32" || t == "float64" {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the unsigned type that the value of the type is stored as.
+func storage(t string) string {
+	switch t {
+	case "float64", "int64", "uint64":
+		return "uint64"
+	case "bool":
+		return "uint8"
+	}
+	return "uint32"
+}
+
+// Returns the expression that loads a value of the type from the variable.
+func load(t, v string) string {
+	switch t {
+	case "float32":
+		return fmt.Sprintf("math.Float32frombits(volatile.LoadUint32(&%s))", v)
+	case "float64":
+		return fmt.Sprintf("math.Float64frombits(volatile.LoadUint64(&%s))", v)
+	case "bool":
+		return fmt.Sprintf("volatile.LoadUint8(&%s) != 0", v)
+	case "int64", "uint64":
+		return fmt.Sprintf("%s(volatile.LoadUint64(&%s))", t, v)
+	}
+	return fmt.Sprintf("%s(volatile.LoadUint32(&%s))", t, v)
+}
+
+// Returns the statement that stores the value `r` of the type into the variable.
+func store(t, v, r string) string {
+	switch t {
+	case "float32":
+		return fmt.Sprintf("volatile.StoreUint32(&%s, math.Float32bits(%s))", v, r)
+	case "float64":
+		return fmt.Sprintf("volatile.StoreUint64(&%s, math.Float64bits(%s))", v, r)
+	case "bool":
+		return fmt.Sprintf("if %s {\n\t\tvolatile.StoreUint8(&%s, 1)\n\t}", r, v)
+	case "int64", "uint64":
+		return fmt.Sprintf("volatile.StoreUint64(&%s, uint64(%s))", v, r)
+	}
+	return fmt.Sprintf("volatile.StoreUint32(&%s, uint32(%s))", v, r)
+}
+
+// The flash column of `tinygo build -size short`.
+var sizeRe = regexp.MustCompile(`(?m)^\s*\d+\s+\d+\s+\d+\s+\|\s+(\d+)\s+\d+\s*$`)
+
+// Builds the program with TinyGo.
+//
+// The size is the flash size reported by TinyGo. If TinyGo doesn't report it
+// (like for some wasm targets), it's the size of the binary.
+func tinygoBuild(dir, target string) (int, error) {
+	out := filepath.Join(dir, "out.bin")
+	defer os.Remove(out)
+	cmd := exec.Command("tinygo", "build", "-o", out, "-target", target, "-no-debug", "-size", "short", ".")
+	cmd.Dir = dir
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", err, bytes.TrimSpace(output))
+	}
+	if m := sizeRe.FindSubmatch(output); m != nil {
+		return strconv.Atoi(string(m[1]))
+	}
+	stat, err := os.Stat(out)
+	if err != nil {
+		return 0, err
+	}
+	return int(stat.Size()), nil
+}
+
+// Writes the program into a new directory inside of `root` and builds it.
+func buildSource(root string, src []byte, target string, b builder) (int, error) {
+	dir, err := os.MkdirTemp(root, "_sizebench")
+	if err != nil {
+		return 0, err
+	}
+	defer os.RemoveAll(dir)
+	if err := os.WriteFile(filepath.Join(dir, "main.go"), src, 0o644); err != nil {
+		return 0, err
+	}
+	return b(dir, target)
+}
+
+var errNoTinyGo = errors.New("tinygo not found in PATH, install it from https://tinygo.org/")
@@ -0,0 +1,160 @@
+// Command sizebench measures how much code each tinymath function adds to a binary
+// when built with TinyGo, compared to the matching function from the math package.
+//
+// For every exported function, it generates a small program that calls the function
+// and builds it for each target. The reported size is the difference with an empty program.
+// Targets that can't be built (for example, because the toolchain for them isn't installed)
+// are reported and skipped. With -budget, they are reported as errors.
+//
+//	go run ./cmd/sizebench -targets wasm-unknown,cortex-m-qemu
+//
+// In CI, use -budget to fail when a function grows past the allowed size.
+// The budget file can be created from the current sizes with -write-budget.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+type config struct {
+	dir         string
+	targets     []string
+	run         *regexp.Regexp
+	format      string
+	std         bool
+	budget      string
+	writeBudget string
+	slack       int
+}
+
+func main() {
+	var c config
+	var targets, run string
+	flag.StringVar(&c.dir, "dir", ".", "the directory of the tinymath package")
+	flag.StringVar(&targets, "targets", "wasm-unknown,cortex-m-qemu,riscv-qemu", "comma-separated TinyGo targets")
+	flag.StringVar(&run, "run", "", "only measure functions matching the regular expression")
+	flag.StringVar(&c.format, "format", "markdown", "the output format: markdown or json")
+	flag.BoolVar(&c.std, "std", true, "also measure matching functions from the math package")
+	flag.StringVar(&c.budget, "budget", "", "fail if any function is bigger than in this JSON file")
+	flag.StringVar(&c.writeBudget, "write-budget", "", "write the current sizes as a budget into this JSON file")
+	flag.IntVar(&c.slack, "slack", 10, "how many percent to add on top of the current sizes for -write-budget")
+	flag.Parse()
+
+	c.targets = strings.Split(targets, ",")
+	var err error
+	c.run, err = regexp.Compile(run)
+	if err != nil {
+		fail(err)
+	}
+	if c.format != "markdown" && c.format != "json" {
+		fail(fmt.Errorf("unknown format %q", c.format))
+	}
+	if _, err := exec.LookPath("tinygo"); err != nil {
+		fail(errNoTinyGo)
+	}
+	if err := bench(os.Stdout, c, tinygoBuild); err != nil {
+		fail(err)
+	}
+}
+
+func bench(w io.Writer, c config, b builder) error {
+	funcs, err := collect(c.dir)
+	if err != nil {
+		return err
+	}
+	results, skipped, err := measure(c, funcs, b)
+	if err != nil {
+		return err
+	}
+
+	if c.format == "json" {
+		err = writeJSON(w, results)
+		if err != nil {
+			return err
+		}
+	} else {
+		writeMarkdown(w, results)
+	}
+
+	if c.writeBudget != "" {
+		err = writeBudget(c.writeBudget, makeBudget(results, c.slack))
+		if err != nil {
+			return err
+		}
+	}
+	if c.budget != "" {
+		bud, err := readBudget(c.budget)
+		if err != nil {
+			return err
+		}
+		// A target that can't be built can't be checked, and that must not pass silently.
+		errs := append(skipped, checkBudget(results, bud)...)
+		if len(errs) > 0 {
+			return fmt.Errorf("budget check failed:\n%s", strings.Join(errs, "\n"))
+		}
+	}
+	return nil
+}
+
+// Builds wrappers for all functions on all targets.
+//
+// Returns the results and descriptions of targets that were skipped
+// because even an empty program can't be built for them.
+func measure(c config, funcs []function, b builder) ([]result, []string, error) {
+	root, err := filepath.Abs(c.dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	var results []result
+	var skipped []string
+	for _, target := range c.targets {
+		base, err := buildSource(root, []byte(baseline), target, b)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "skipping %s: %v\n", target, err)
+			skipped = append(skipped, fmt.Sprintf("%s: can't build: %v", target, err))
+			continue
+		}
+		for _, f := range funcs {
+			if !c.run.MatchString(f.Name) {
+				continue
+			}
+			r := result{Target: target, Function: f.Name}
+			r.Tinymath, err = measureOne(root, f, false, target, base, b)
+			if err != nil {
+				return nil, nil, err
+			}
+			if c.std && f.Std != "" {
+				r.Stdlib, err = measureOne(root, f, true, target, base, b)
+				if err != nil {
+					return nil, nil, err
+				}
+			}
+			results = append(results, r)
+		}
+	}
+	return results, skipped, nil
+}
+
+func measureOne(root string, f function, std bool, target string, base int, b builder) (int, error) {
+	src, err := wrapper(f, std)
+	if err != nil {
+		return 0, fmt.Errorf("generate %s: %w", f.Name, err)
+	}
+	size, err := buildSource(root, src, target, b)
+	if err != nil {
+		return 0, fmt.Errorf("build %s for %s: %w", f.Name, target, err)
+	}
+	return max(size-base, 0), nil
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestWrapper(t *testing.T) {
+	t.Parallel()
+	funcs := []function{
+		{Name: "Sin", Params: []string{"float32"}, Results: []string{"float32"}, Std: "Sin"},
+		{Name: "Frexp", Params: []string{"float32"}, Results: []string{"float32", "int32"}, Std: "Frexp"},
+		{Name: "IsNaN", Params: []string{"float32"}, Results: []string{"bool"}, Std: "IsNaN"},
+		{Name: "FromBits", Params: []string{"uint32"}, Results: []string{"float32"}},
+		{Name: "Id", Params: []string{"int64"}, Results: []string{"uint64"}},
+	}
+	for _, f := range funcs {
+		for _, std := range []bool{false, true} {
+			if std && f.Std == "" {
+				continue
+			}
+			src, err := wrapper(f, std)
+			if err != nil {
+				t.Fatalf("%s: %v", f.Name, err)
+			}
+			_, err = parser.ParseFile(token.NewFileSet(), "main.go", src, 0)
+			if err != nil {
+				t.Fatalf("%s:\n%s\n%v", f.Name, src, err)
+			}
+		}
+	}
+
+	src, _ := wrapper(funcs[1], false)
+	exp := "r0, r1 := tinymath.Frexp(math.Float32frombits(volatile.LoadUint32(&in0)))"
+	if !strings.Contains(string(src), exp) {
+		t.Fatalf("no call in:\n%s", src)
+	}
+	src, _ = wrapper(funcs[1], true)
+	exp = "r0, r1 := math.Frexp(math.Float64frombits(volatile.LoadUint64(&in0)))"
+	if !strings.Contains(string(src), exp) {
+		t.Fatalf("no call in:\n%s", src)
+	}
+	src, _ = wrapper(funcs[4], false)
+	if strings.Contains(string(src), `"math"`) {
+		t.Fatalf("unused import in:\n%s", src)
+	}
+}
+
+func TestSizeRe(t *testing.T) {
+	t.Parallel()
+	output := "   code    data     bss |   flash     ram\n    512       4    2048 |     516    2052\n"
+	m := sizeRe.FindStringSubmatch(output)
+	if m == nil || m[1] != "516" {
+		t.Fatalf("%v", m)
+	}
+}
+
+// Pretends to build the program, the size is the length of the source code.
+func fakeBuild(dir, target string) (int, error) {
+	if target == "broken" {
+		return 0, errors.New("no toolchain")
+	}
+	src, err := os.ReadFile(filepath.Join(dir, "main.go"))
+	return len(src), err
+}
+
+// Not parallel: it checks that temporary directories are removed.
+func TestBench(t *testing.T) {
+	c := config{
+		dir:     "../..",
+		targets: []string{"wasm-unknown", "broken"},
+		run:     regexp.MustCompile("^(Sin|Recip)$"),
+		format:  "json",
+		std:     true,
+	}
+	var buf bytes.Buffer
+	if err := bench(&buf, c, fakeBuild); err != nil {
+		t.Fatal(err)
+	}
+	var results []result
+	if err := json.Unmarshal(buf.Bytes(), &results); err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("%+v", results)
+	}
+	if results[0].Function != "Recip" || results[0].Stdlib != 0 || results[0].Tinymath == 0 {
+		t.Fatalf("%+v", results[0])
+	}
+	if results[1].Function != "Sin" || results[1].Stdlib == 0 || results[1].Target != "wasm-unknown" {
+		t.Fatalf("%+v", results[1])
+	}
+
+	// The generated directories are removed.
+	matches, _ := filepath.Glob("../../_sizebench*")
+	if len(matches) != 0 {
+		t.Fatalf("not removed: %v", matches)
+	}
+}
+
+func TestBenchBudget(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "budget.json")
+	c := config{
+		dir:         "../..",
+		targets:     []string{"wasm-unknown"},
+		run:         regexp.MustCompile("^Sin$"),
+		format:      "markdown",
+		writeBudget: path,
+	}
+	var buf bytes.Buffer
+	if err := bench(&buf, c, fakeBuild); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "| Sin          |") {
+		t.Fatalf("no Sin in:\n%s", buf.String())
+	}
+
+	// The sizes fit into the budget written from them.
+	c.writeBudget = ""
+	c.budget = path
+	if err := bench(&buf, c, fakeBuild); err != nil {
+		t.Fatal(err)
+	}
+
+	// Reduce the budget.
+	err := writeBudget(path, budget{"wasm-unknown": {"Sin": 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = bench(&buf, c, fakeBuild)
+	if err == nil || !strings.Contains(err.Error(), "Sin on wasm-unknown") {
+		t.Fatalf("%v", err)
+	}
+
+	// A target that can't be built fails the check.
+	err = writeBudget(path, budget{"wasm-unknown": {"Sin": 10000}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.targets = []string{"wasm-unknown", "broken"}
+	err = bench(&buf, c, fakeBuild)
+	if err == nil || !strings.Contains(err.Error(), "broken: can't build: no toolchain") {
+		t.Fatalf("%v", err)
+	}
+	if strings.Contains(err.Error(), "Sin on wasm-unknown") {
+		t.Fatalf("%v", err)
+	}
+}
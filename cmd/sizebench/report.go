@@ -0,0 +1,107 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+)
+
+// The size of one function on one target.
+type result struct {
+	Target   string `json:"target"`
+	Function string `json:"function"`
+	// The size in bytes that the function adds to an empty program.
+	Tinymath int `json:"tinymath"`
+	// The size of the matching function from the math package, zero if there is none.
+	Stdlib int `json:"stdlib,omitempty"`
+}
+
+// The max allowed sizes for each target and function.
+type budget map[string]map[string]int
+
+func writeMarkdown(w io.Writer, results []result) {
+	targets := groupByTarget(results)
+	names := make([]string, 0, len(targets))
+	for name := range targets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for i, target := range names {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "### %s\n\n", target)
+		fmt.Fprintln(w, "| function     | tinymath | stdlib | ratio |")
+		fmt.Fprintln(w, "| ------------ | --------:| ------:| -----:|")
+		for _, r := range targets[target] {
+			std, ratio := "", ""
+			if r.Stdlib > 0 {
+				std = fmt.Sprint(r.Stdlib)
+				ratio = fmt.Sprintf("%d%%", r.Tinymath*100/r.Stdlib)
+			}
+			fmt.Fprintf(w, "| %-12s | %8d | %6s | %5s |\n", r.Function, r.Tinymath, std, ratio)
+		}
+	}
+}
+
+func writeJSON(w io.Writer, results []result) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(results)
+}
+
+func groupByTarget(results []result) map[string][]result {
+	res := make(map[string][]result)
+	for _, r := range results {
+		res[r.Target] = append(res[r.Target], r)
+	}
+	return res
+}
+
+func readBudget(path string) (budget, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var b budget
+	if err := json.Unmarshal(data, &b); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	return b, nil
+}
+
+// Creates a budget from the current sizes, with `slack` percent on top.
+func makeBudget(results []result, slack int) budget {
+	b := make(budget)
+	for _, r := range results {
+		if b[r.Target] == nil {
+			b[r.Target] = make(map[string]int)
+		}
+		b[r.Target][r.Function] = r.Tinymath + r.Tinymath*slack/100
+	}
+	return b
+}
+
+func writeBudget(path string, b budget) error {
+	data, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// Returns descriptions of all functions that are bigger than the budget allows.
+//
+// Functions and targets that aren't in the budget are not checked.
+func checkBudget(results []result, b budget) []string {
+	var errs []string
+	for _, r := range results {
+		limit, ok := b[r.Target][r.Function]
+		if ok && r.Tinymath > limit {
+			errs = append(errs, fmt.Sprintf("%s on %s: %d bytes, budget is %d", r.Function, r.Target, r.Tinymath, limit))
+		}
+	}
+	return errs
+}
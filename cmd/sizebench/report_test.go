@@ -0,0 +1,55 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWriteMarkdown(t *testing.T) {
+	t.Parallel()
+	results := []result{
+		{Target: "wasm-unknown", Function: "Sin", Tinymath: 125, Stdlib: 1237},
+		{Target: "cortex-m-qemu", Function: "Sin", Tinymath: 96, Stdlib: 1024},
+		{Target: "wasm-unknown", Function: "Recip", Tinymath: 60},
+	}
+	var buf bytes.Buffer
+	writeMarkdown(&buf, results)
+	exp := `### cortex-m-qemu
+
+| function     | tinymath | stdlib | ratio |
+| ------------ | --------:| ------:| -----:|
+| Sin          |       96 |   1024 |    9% |
+
+### wasm-unknown
+
+| function     | tinymath | stdlib | ratio |
+| ------------ | --------:| ------:| -----:|
+| Sin          |      125 |   1237 |   10% |
+| Recip        |       60 |        |       |
+`
+	if buf.String() != exp {
+		t.Fatalf("\n%s", buf.String())
+	}
+}
+
+func TestBudget(t *testing.T) {
+	t.Parallel()
+	results := []result{
+		{Target: "wasm-unknown", Function: "Sin", Tinymath: 100},
+		{Target: "wasm-unknown", Function: "Cos", Tinymath: 200},
+		{Target: "cortex-m-qemu", Function: "Sin", Tinymath: 300},
+	}
+	b := makeBudget(results, 10)
+	if b["wasm-unknown"]["Sin"] != 110 || b["cortex-m-qemu"]["Sin"] != 330 {
+		t.Fatalf("%v", b)
+	}
+	if errs := checkBudget(results, b); len(errs) != 0 {
+		t.Fatalf("%v", errs)
+	}
+
+	b = budget{"wasm-unknown": {"Sin": 99, "Cos": 200}}
+	errs := checkBudget(results, b)
+	if len(errs) != 1 || errs[0] != "Sin on wasm-unknown: 100 bytes, budget is 99" {
+		t.Fatalf("%v", errs)
+	}
+}
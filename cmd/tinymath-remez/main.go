@@ -0,0 +1,220 @@
+// Command tinymath-remez fits minimax polynomial and rational approximations
+// using the Remez exchange algorithm and generates Go source with the coefficients.
+//
+// For example, to reproduce the polynomial used by [tinymath.Exp2]:
+//
+//	go run github.com/orsinium-labs/tinymath/cmd/tinymath-remez -func exp2 -from 0 -to 1 -degree 5 -metric rel
+//
+// The coefficients can be evaluated with [tinymath.Horner] or, for rational functions,
+// with [tinymath.Rational]. Run with -list to see all supported functions.
+//
+// [tinymath.Exp2]: https://pkg.go.dev/github.com/orsinium-labs/tinymath#Exp2
+// [tinymath.Horner]: https://pkg.go.dev/github.com/orsinium-labs/tinymath#Horner
+// [tinymath.Rational]: https://pkg.go.dev/github.com/orsinium-labs/tinymath#Rational
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Functions that can be approximated.
+var functions = map[string]func(float64) float64{
+	"acos":  math.Acos,
+	"asin":  math.Asin,
+	"atan":  math.Atan,
+	"cbrt":  math.Cbrt,
+	"cos":   math.Cos,
+	"exp":   math.Exp,
+	"exp2":  math.Exp2,
+	"expm1": math.Expm1,
+	"ln":    math.Log,
+	"log1p": math.Log1p,
+	"log2":  math.Log2,
+	"log10": math.Log10,
+	"recip": func(x float64) float64 { return 1 / x },
+	"rsqrt": func(x float64) float64 { return 1 / math.Sqrt(x) },
+	"sin":   math.Sin,
+	"sqrt":  math.Sqrt,
+	"tan":   math.Tan,
+	"tanh":  math.Tanh,
+}
+
+type config struct {
+	fn       string
+	from, to float64
+	degree   int
+	den      int
+	metric   string
+	name     string
+	pkg      string
+}
+
+func main() {
+	var c config
+	var out string
+	var list bool
+	flag.StringVar(&c.fn, "func", "", "the function to approximate, see -list")
+	flag.Float64Var(&c.from, "from", 0, "the start of the interval")
+	flag.Float64Var(&c.to, "to", 1, "the end of the interval")
+	flag.IntVar(&c.degree, "degree", 4, "the degree of the polynomial (the numerator for rational functions)")
+	flag.IntVar(&c.den, "den", 0, "the degree of the denominator, zero for a polynomial")
+	flag.StringVar(&c.metric, "metric", "abs", "the error to minimize: abs or rel")
+	flag.StringVar(&c.name, "name", "", "the variable name, the function name with Coeffs suffix by default")
+	flag.StringVar(&c.pkg, "pkg", "", "generate a whole file for this package instead of a snippet")
+	flag.StringVar(&out, "out", "", "the output file path, stdout if empty")
+	flag.BoolVar(&list, "list", false, "list supported functions")
+	flag.Parse()
+
+	if list {
+		fmt.Println(strings.Join(functionNames(), "\n"))
+		return
+	}
+	var w io.Writer = os.Stdout
+	if out != "" {
+		f, err := os.Create(out)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		w = f
+	}
+	if err := run(w, c); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(w io.Writer, c config) error {
+	f, ok := functions[c.fn]
+	if !ok {
+		return fmt.Errorf("unknown function %q, supported: %s", c.fn, strings.Join(functionNames(), ", "))
+	}
+	if c.metric != "abs" && c.metric != "rel" {
+		return errors.New("the metric must be abs or rel")
+	}
+	pr := problem{f: f, a: c.from, b: c.to, num: c.degree, den: c.den, relative: c.metric == "rel"}
+	res, err := fit(pr)
+	if err != nil {
+		return err
+	}
+	src, err := generate(c, pr, res)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(src)
+	return err
+}
+
+// Generates Go source code with the coefficients.
+func generate(c config, pr problem, res result) ([]byte, error) {
+	name := c.name
+	if name == "" {
+		name = c.fn + "Coeffs"
+	}
+	metric := "absolute"
+	if pr.relative {
+		metric = "relative"
+	}
+	kind := fmt.Sprintf("polynomial of degree %d", c.degree)
+	if c.den > 0 {
+		kind = fmt.Sprintf("rational function of degree %d/%d", c.degree, c.den)
+	}
+
+	var buf bytes.Buffer
+	if c.pkg != "" {
+		fmt.Fprintln(&buf, "// Code generated by tinymath-remez. DO NOT EDIT.")
+		fmt.Fprintln(&buf)
+		fmt.Fprintf(&buf, "package %s\n\n", c.pkg)
+	}
+	fmt.Fprintf(&buf, "// Minimax approximation of %s(x) on [%g, %g], %s.\n", c.fn, c.from, c.to, kind)
+	fmt.Fprintf(&buf, "// Max %s error: %.2e (%.2e with float32 coefficients).\n", metric, res.maxErr, float32Error(pr, res))
+	fmt.Fprintf(&buf, "//\n// Generated by: %s\n", command(c))
+	if c.den == 0 {
+		buf.WriteString("var ")
+		writeArray(&buf, name, res.p)
+	} else {
+		fmt.Fprintln(&buf, "var (")
+		writeArray(&buf, name+"P", res.p)
+		writeArray(&buf, name+"Q", res.q)
+		fmt.Fprintln(&buf, ")")
+	}
+	return format.Source(buf.Bytes())
+}
+
+func writeArray(buf *bytes.Buffer, name string, coeffs []float64) {
+	fmt.Fprintf(buf, "%s = [...]float32{", name)
+	for i, c := range coeffs {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(strconv.FormatFloat(float64(float32(c)), 'g', -1, 32))
+	}
+	buf.WriteString("}\n")
+}
+
+// Returns the max error when coefficients are rounded to float32
+// and the approximation is evaluated in float32, like tinymath does.
+func float32Error(pr problem, res result) float64 {
+	p := toFloat32(res.p)
+	q := toFloat32(res.q)
+	var maxErr float64
+	for i := 0; i <= gridSize; i++ {
+		x := float32(pr.a + (pr.b-pr.a)*float64(i)/gridSize)
+		y := pr.f(float64(x))
+		act := float64(horner32(p, x) / horner32(q, x))
+		e := math.Abs(y - act)
+		if pr.relative {
+			e /= math.Abs(y)
+		}
+		maxErr = math.Max(maxErr, e)
+	}
+	return maxErr
+}
+
+func toFloat32(c []float64) []float32 {
+	res := make([]float32, len(c))
+	for i, v := range c {
+		res[i] = float32(v)
+	}
+	return res
+}
+
+func horner32(c []float32, x float32) float32 {
+	var r float32
+	for i := len(c) - 1; i >= 0; i-- {
+		r = r*x + c[i]
+	}
+	return r
+}
+
+// Returns the command that reproduces the result.
+func command(c config) string {
+	cmd := fmt.Sprintf("tinymath-remez -func %s -from %g -to %g -degree %d", c.fn, c.from, c.to, c.degree)
+	if c.den > 0 {
+		cmd += fmt.Sprintf(" -den %d", c.den)
+	}
+	if c.metric != "abs" {
+		cmd += " -metric " + c.metric
+	}
+	return cmd
+}
+
+func functionNames() []string {
+	names := make([]string, 0, len(functions))
+	for name := range functions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
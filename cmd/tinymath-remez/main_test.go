@@ -0,0 +1,139 @@
+package main
+
+import (
+	"bytes"
+	"go/parser"
+	"go/token"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestFitLn(t *testing.T) {
+	t.Parallel()
+	res, err := fit(problem{f: math.Log, a: 1, b: 2, num: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.maxErr > 6.1e-5 {
+		t.Fatalf("max error: %g", res.maxErr)
+	}
+	// The coefficients used by tinymath.Ln.
+	exp := []float64{-1.741_793_9, 2.821_202_6, -1.469_956_8, 0.447_179_55, -0.056_570_851}
+	for i := range exp {
+		if math.Abs(res.p[i]-exp[i]) > 1e-4 {
+			t.Fatalf("coefficient %d: %f != %f", i, res.p[i], exp[i])
+		}
+	}
+}
+
+// The error of the minimax approximation oscillates between +E and -E
+// at n+2 points (the Chebyshev alternation theorem).
+func TestFitEquioscillation(t *testing.T) {
+	t.Parallel()
+	for _, pr := range []problem{
+		{f: math.Exp, a: 0, b: 1, num: 3},
+		{f: math.Exp, a: -1, b: 1, num: 5, relative: true},
+		{f: math.Sin, a: 0, b: math.Pi / 2, num: 5},
+		{f: math.Atan, a: 0, b: 1, num: 3, den: 2},
+	} {
+		res, err := fit(pr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		grid := make([]float64, gridSize+1)
+		for i := range grid {
+			grid[i] = pr.a + (pr.b-pr.a)*float64(i)/gridSize
+		}
+		e := func(x float64) float64 { return errorAt(pr, res.p, res.q, x) }
+		n := pr.num + pr.den + 2
+		extrema := findExtrema(e, grid, n)
+		if len(extrema) != n {
+			t.Fatalf("%d extrema, expected %d", len(extrema), n)
+		}
+		for i, x := range extrema {
+			v := e(x)
+			if math.Abs(math.Abs(v)-res.maxErr) > 1e-6*res.maxErr {
+				t.Fatalf("extremum %d: %g != %g", i, v, res.maxErr)
+			}
+			if i > 0 && (v > 0) == (e(extrema[i-1]) > 0) {
+				t.Fatalf("extremum %d has the same sign as the previous one", i)
+			}
+		}
+	}
+}
+
+func TestFitDegree(t *testing.T) {
+	t.Parallel()
+	prev := math.Inf(1)
+	for deg := 1; deg <= 8; deg++ {
+		res, err := fit(problem{f: math.Exp, a: 0, b: 1, num: deg})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.maxErr >= prev/5 {
+			t.Fatalf("degree %d: %g, previous: %g", deg, res.maxErr, prev)
+		}
+		prev = res.maxErr
+	}
+}
+
+func TestFitErrors(t *testing.T) {
+	t.Parallel()
+	_, err := fit(problem{f: math.Log, a: 1, b: 1, num: 2})
+	if err == nil {
+		t.Fatal("empty interval")
+	}
+	_, err = fit(problem{f: math.Log, a: 0, b: 1, num: 2})
+	if err == nil {
+		t.Fatal("not finite")
+	}
+	_, err = fit(problem{f: math.Sin, a: 0, b: 1, num: 2, relative: true})
+	if err == nil {
+		t.Fatal("relative error with zero")
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	err := run(&buf, config{fn: "atan", from: 0, to: 1, degree: 3, den: 2, metric: "abs", pkg: "approx"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := buf.String()
+	f, err := parser.ParseFile(token.NewFileSet(), "atan.go", src, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.Name.Name != "approx" {
+		t.Fatalf("package: %s", f.Name.Name)
+	}
+	for _, name := range []string{"atanCoeffsP", "atanCoeffsQ"} {
+		if f.Scope.Lookup(name) == nil {
+			t.Fatalf("no %s in:\n%s", name, src)
+		}
+	}
+	if !strings.Contains(src, "Generated by: tinymath-remez -func atan -from 0 -to 1 -degree 3 -den 2\n") {
+		t.Fatalf("no command in:\n%s", src)
+	}
+
+	buf.Reset()
+	err = run(&buf, config{fn: "exp", from: -1, to: 1, degree: 4, metric: "rel", name: "expPoly"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(buf.String(), "// Minimax approximation of exp(x) on [-1, 1], polynomial of degree 4.\n// Max relative error:") {
+		t.Fatalf("unexpected output:\n%s", buf.String())
+	}
+	if !strings.Contains(buf.String(), "var expPoly = [...]float32{") {
+		t.Fatalf("no variable in:\n%s", buf.String())
+	}
+
+	if err := run(&buf, config{fn: "nope", metric: "abs"}); err == nil {
+		t.Fatal("unknown function")
+	}
+	if err := run(&buf, config{fn: "exp", from: 0, to: 1, degree: 2, metric: "max"}); err == nil {
+		t.Fatal("unknown metric")
+	}
+}
@@ -0,0 +1,302 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// A function to approximate and how.
+type problem struct {
+	f func(float64) float64
+	// The interval.
+	a, b float64
+	// The degree of the numerator.
+	num int
+	// The degree of the denominator, zero for a polynomial.
+	den int
+	// Minimize the relative error instead of the absolute one.
+	relative bool
+}
+
+// The best approximation found.
+type result struct {
+	// Coefficients of the numerator, from the lowest degree.
+	p []float64
+	// Coefficients of the denominator, from the lowest degree. The first one is always 1.
+	q []float64
+	// The max error (absolute or relative) on the interval.
+	maxErr float64
+	// The number of Remez iterations done.
+	iterations int
+}
+
+const (
+	maxIterations = 100
+	// The number of points for searching the error extrema.
+	gridSize = 4000
+	// The precision of big.Float for solving linear systems.
+	solvePrec = 256
+)
+
+// Finds the minimax approximation using the Remez exchange algorithm.
+func fit(pr problem) (result, error) {
+	if !(pr.a < pr.b) {
+		return result{}, errors.New("the interval is empty")
+	}
+	if pr.num < 0 || pr.den < 0 {
+		return result{}, errors.New("the degree must not be negative")
+	}
+	grid := make([]float64, gridSize+1)
+	for i := range grid {
+		grid[i] = pr.a + (pr.b-pr.a)*float64(i)/gridSize
+		y := pr.f(grid[i])
+		if math.IsNaN(y) || math.IsInf(y, 0) {
+			return result{}, fmt.Errorf("the function is not finite at %g", grid[i])
+		}
+		if pr.relative && y == 0 {
+			return result{}, fmt.Errorf("the function is zero at %g, use the absolute error", grid[i])
+		}
+	}
+
+	// The reference points start at Chebyshev nodes (extrema of the Chebyshev polynomial),
+	// which are close to the final ones for smooth functions.
+	n := pr.num + pr.den + 2
+	ref := make([]float64, n)
+	for i := range ref {
+		ref[i] = (pr.a+pr.b)/2 - (pr.b-pr.a)/2*math.Cos(math.Pi*float64(i)/float64(n-1))
+	}
+
+	var res result
+	q := []float64{1}
+	for iter := 1; iter <= maxIterations; iter++ {
+		var p []float64
+		var levelled float64
+		var err error
+		// For rational functions, the system is not linear because the error is multiplied by Q.
+		// The previous Q is used instead, until it converges.
+		for inner := 0; inner < 50; inner++ {
+			var qNew []float64
+			p, qNew, levelled, err = solveReference(pr, ref, q)
+			if err != nil {
+				return result{}, err
+			}
+			done := pr.den == 0 || maxDiff(q, qNew) < 1e-14
+			q = qNew
+			if done {
+				break
+			}
+		}
+		res = result{p: p, q: q, iterations: iter}
+
+		e := func(x float64) float64 { return errorAt(pr, p, q, x) }
+		for _, x := range grid {
+			if horner(q, x) <= 0 {
+				return result{}, fmt.Errorf("the denominator has a zero at %g, try a different degree", x)
+			}
+		}
+		extrema := findExtrema(e, grid, n)
+		res.maxErr = 0
+		for _, x := range extrema {
+			res.maxErr = math.Max(res.maxErr, math.Abs(e(x)))
+		}
+		if len(extrema) == n {
+			ref = extrema
+		}
+		if res.maxErr-math.Abs(levelled) <= 1e-9*res.maxErr {
+			return res, nil
+		}
+	}
+	return res, nil
+}
+
+// Solves the system `P(x_i) = Q(x_i) * (f(x_i) + (-1)^i * E / w(x_i))` for P, Q, and E.
+//
+// `qPrev` is used in place of Q in the error term to keep the system linear.
+func solveReference(pr problem, ref []float64, qPrev []float64) ([]float64, []float64, float64, error) {
+	n := len(ref)
+	m := make([][]float64, n)
+	rhs := make([]float64, n)
+	for i, x := range ref {
+		y := pr.f(x)
+		row := make([]float64, n)
+		xj := 1.0
+		for j := 0; j <= pr.num; j++ {
+			row[j] = xj
+			xj *= x
+		}
+		xk := x
+		for k := 1; k <= pr.den; k++ {
+			row[pr.num+k] = -y * xk
+			xk *= x
+		}
+		sign := 1.0
+		if i%2 == 1 {
+			sign = -1
+		}
+		w := 1.0
+		if pr.relative {
+			w = math.Abs(y)
+		}
+		row[n-1] = -sign * w * horner(qPrev, x)
+		m[i] = row
+		rhs[i] = y
+	}
+	sol, err := solve(m, rhs)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	p := sol[:pr.num+1]
+	q := append([]float64{1}, sol[pr.num+1:n-1]...)
+	return p, q, sol[n-1], nil
+}
+
+// Returns the error of the approximation at x.
+func errorAt(pr problem, p, q []float64, x float64) float64 {
+	y := pr.f(x)
+	e := y - horner(p, x)/horner(q, x)
+	if pr.relative {
+		e /= math.Abs(y)
+	}
+	return e
+}
+
+// Finds the local extrema of the error function with alternating signs.
+//
+// Returns `n` points or, if the approximation isn't good enough yet, fewer.
+func findExtrema(e func(float64) float64, grid []float64, n int) []float64 {
+	// Split the grid into runs of the same sign and find the max in each run.
+	type extremum struct {
+		i   int
+		abs float64
+	}
+	var runs []extremum
+	prevSign := 0.0
+	for i, x := range grid {
+		v := e(x)
+		sign := math.Copysign(1, v)
+		if len(runs) == 0 || sign != prevSign {
+			runs = append(runs, extremum{i, math.Abs(v)})
+			prevSign = sign
+			continue
+		}
+		last := &runs[len(runs)-1]
+		if math.Abs(v) > last.abs {
+			*last = extremum{i, math.Abs(v)}
+		}
+	}
+	// Drop the smaller of the outermost extrema until the number is right.
+	// Runs alternate in sign, so the remaining ones alternate too.
+	for len(runs) > n {
+		if runs[0].abs < runs[len(runs)-1].abs {
+			runs = runs[1:]
+		} else {
+			runs = runs[:len(runs)-1]
+		}
+	}
+	points := make([]float64, len(runs))
+	for j, r := range runs {
+		lo := grid[max(r.i-1, 0)]
+		hi := grid[min(r.i+1, len(grid)-1)]
+		points[j] = refineMax(e, lo, hi)
+	}
+	return points
+}
+
+// Finds the point of max |e(x)| on the interval with the golden section search.
+func refineMax(e func(float64) float64, lo, hi float64) float64 {
+	f := func(x float64) float64 { return math.Abs(e(x)) }
+	const phi = 0.6180339887498949
+	x1 := hi - phi*(hi-lo)
+	x2 := lo + phi*(hi-lo)
+	f1, f2 := f(x1), f(x2)
+	for i := 0; i < 60; i++ {
+		if f1 > f2 {
+			hi, x2, f2 = x2, x1, f1
+			x1 = hi - phi*(hi-lo)
+			f1 = f(x1)
+		} else {
+			lo, x1, f1 = x1, x2, f2
+			x2 = lo + phi*(hi-lo)
+			f2 = f(x2)
+		}
+	}
+	// The extremum can be at the interval boundary.
+	best := (lo + hi) / 2
+	for _, x := range []float64{lo, hi} {
+		if f(x) > f(best) {
+			best = x
+		}
+	}
+	return best
+}
+
+// Solves the linear system with Gaussian elimination in high precision.
+//
+// The system for the monomial basis is ill-conditioned, float64 isn't enough for it.
+func solve(m [][]float64, rhs []float64) ([]float64, error) {
+	n := len(rhs)
+	a := make([][]*big.Float, n)
+	for i := range a {
+		a[i] = make([]*big.Float, n+1)
+		for j := 0; j < n; j++ {
+			a[i][j] = new(big.Float).SetPrec(solvePrec).SetFloat64(m[i][j])
+		}
+		a[i][n] = new(big.Float).SetPrec(solvePrec).SetFloat64(rhs[i])
+	}
+	tmp := new(big.Float).SetPrec(solvePrec)
+	abs := new(big.Float).SetPrec(solvePrec)
+	for col := 0; col < n; col++ {
+		pivot := col
+		for i := col + 1; i < n; i++ {
+			if abs.Abs(a[i][col]).Cmp(tmp.Abs(a[pivot][col])) > 0 {
+				pivot = i
+			}
+		}
+		if a[pivot][col].Sign() == 0 {
+			return nil, errors.New("the system is singular, try a different degree")
+		}
+		a[col], a[pivot] = a[pivot], a[col]
+		for i := col + 1; i < n; i++ {
+			factor := new(big.Float).SetPrec(solvePrec).Quo(a[i][col], a[col][col])
+			for j := col; j <= n; j++ {
+				tmp.Mul(factor, a[col][j])
+				a[i][j].Sub(a[i][j], tmp)
+			}
+		}
+	}
+	x := make([]*big.Float, n)
+	for i := n - 1; i >= 0; i-- {
+		s := new(big.Float).SetPrec(solvePrec).Set(a[i][n])
+		for j := i + 1; j < n; j++ {
+			tmp.Mul(a[i][j], x[j])
+			s.Sub(s, tmp)
+		}
+		x[i] = s.Quo(s, a[i][i])
+	}
+	res := make([]float64, n)
+	for i, v := range x {
+		res[i], _ = v.Float64()
+	}
+	return res, nil
+}
+
+func horner(c []float64, x float64) float64 {
+	var r float64
+	for i := len(c) - 1; i >= 0; i-- {
+		r = r*x + c[i]
+	}
+	return r
+}
+
+func maxDiff(a, b []float64) float64 {
+	if len(a) != len(b) {
+		return math.Inf(1)
+	}
+	var d float64
+	for i := range a {
+		d = math.Max(d, math.Abs(a[i]-b[i]))
+	}
+	return d
+}
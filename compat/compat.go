@@ -0,0 +1,210 @@
+// Package compat mirrors the API of the standard math package on top of tinymath.
+//
+// It has the same names and float64 signatures as math, so porting code
+// is a matter of changing the import:
+//
+//	import math "github.com/orsinium-labs/tinymath/compat"
+//
+// Most functions convert the argument to float32, call the tinymath function,
+// and convert the result back. So, even exact functions (like [Floor])
+// work with the argument rounded to float32: it has only ~7 significant digits
+// and values beyond [MaxFloat32] become infinite.
+// Functions that only inspect or change the sign and the special values
+// (like [Abs] and [IsNaN]) work on float64 directly and are exact.
+// So does [Mod].
+//
+// Functions that are approximated, and their max error
+// for the argument rounded to float32:
+//
+//	| function          | range               | max error            |
+//	| ----------------- | ------------------- | -------------------- |
+//	| Sin, Cos, Sincos  | any                 | 1.1e-3 absolute      |
+//	| Tan               | [-1.5, 1.5]         | 1.5% relative        |
+//	| Asin              | [-1, 1]             | 5e-7 absolute        |
+//	| Acos              | [-1, 1]             | 6e-7 absolute        |
+//	| Atan, Atan2       | any                 | 2.8e-3 absolute      |
+//	| Exp               | [-80, 80]           | 6e-6 relative        |
+//	| Exp2              | [-126, 128)         | 3e-7 relative        |
+//	| Log, Log2, Log10  | (0, +Inf)           | 3e-7 relative        |
+//	| Pow               | [1e-3, 1e3]         | 2e-5 relative        |
+//	| Pow, integer y    | any                 | 1.2e-7·y relative    |
+//	| Sqrt, Hypot       | any                 | 6.1% relative        |
+//
+// For [Pow], the range is of the base and the exponent is in [-10.5, 10.5].
+// The error grows with the exponent because the error of [Log] gets multiplied by it.
+// Integer exponents below 2³¹ use repeated squaring instead,
+// and the error grows with the absolute value of y.
+//
+// [Sqrt] and [Hypot] are exact when TinyGo uses the hardware square root,
+// see the tinymath README. Functions of the math package that have no tinymath
+// implementation are not provided, so using them fails at compile time
+// instead of silently pulling the math package into the binary.
+package compat
+
+import "unsafe"
+
+// Mathematical constants, the same as in the math package.
+const (
+	E   = 2.71828182845904523536028747135266249775724709369995957496696763
+	Pi  = 3.14159265358979323846264338327950288419716939937510582097494459
+	Phi = 1.61803398874989484820458683436563811772030917980576286213544862
+
+	Sqrt2   = 1.41421356237309504880168872420969807856967187537694807317667974
+	SqrtE   = 1.64872127070012814684865078781416357165377610071014801157507931
+	SqrtPi  = 1.77245385090551602729816748334114518279754945612238712821380779
+	SqrtPhi = 1.27201964951406896425242246173749149171560804184009624861664038
+
+	Ln2    = 0.693147180559945309417232121458176568075500134360255254120680009
+	Log2E  = 1 / Ln2
+	Ln10   = 2.30258509299404568401799145468436420760110148862877297603332790
+	Log10E = 1 / Ln10
+)
+
+// Floating-point limit values, the same as in the math package.
+const (
+	MaxFloat32             = 0x1p127 * (1 + (1 - 0x1p-23))
+	SmallestNonzeroFloat32 = 0x1p-126 * 0x1p-23
+
+	MaxFloat64             = 0x1p1023 * (1 + (1 - 0x1p-52))
+	SmallestNonzeroFloat64 = 0x1p-1022 * 0x1p-52
+)
+
+// Integer limit values, the same as in the math package.
+const (
+	intSize = 32 << (^uint(0) >> 63)
+
+	MaxInt    = 1<<(intSize-1) - 1
+	MinInt    = -1 << (intSize - 1)
+	MaxInt8   = 1<<7 - 1
+	MinInt8   = -1 << 7
+	MaxInt16  = 1<<15 - 1
+	MinInt16  = -1 << 15
+	MaxInt32  = 1<<31 - 1
+	MinInt32  = -1 << 31
+	MaxInt64  = 1<<63 - 1
+	MinInt64  = -1 << 63
+	MaxUint   = 1<<intSize - 1
+	MaxUint8  = 1<<8 - 1
+	MaxUint16 = 1<<16 - 1
+	MaxUint32 = 1<<32 - 1
+	MaxUint64 = 1<<64 - 1
+)
+
+const (
+	signMask uint64 = 1 << 63
+	uvnan    uint64 = 0x7FF8000000000001
+	uvinf    uint64 = 0x7FF0000000000000
+	uvneginf uint64 = 0xFFF0000000000000
+)
+
+// Returns the IEEE 754 binary representation of `f`.
+func Float64bits(f float64) uint64 {
+	return *(*uint64)(unsafe.Pointer(&f))
+}
+
+// Returns the floating-point number with the IEEE 754 binary representation `b`.
+func Float64frombits(b uint64) float64 {
+	return *(*float64)(unsafe.Pointer(&b))
+}
+
+// Returns the IEEE 754 binary representation of `f`.
+func Float32bits(f float32) uint32 {
+	return *(*uint32)(unsafe.Pointer(&f))
+}
+
+// Returns the floating-point number with the IEEE 754 binary representation `b`.
+func Float32frombits(b uint32) float32 {
+	return *(*float32)(unsafe.Pointer(&b))
+}
+
+// Returns positive infinity if `sign >= 0`, negative infinity if `sign < 0`.
+func Inf(sign int) float64 {
+	if sign >= 0 {
+		return Float64frombits(uvinf)
+	}
+	return Float64frombits(uvneginf)
+}
+
+// Returns an IEEE 754 "not-a-number" value.
+func NaN() float64 {
+	return Float64frombits(uvnan)
+}
+
+// Reports whether `f` is an IEEE 754 "not-a-number" value.
+func IsNaN(f float64) bool {
+	return f != f
+}
+
+// Reports whether `f` is an infinity, according to `sign`.
+//
+// If `sign > 0`, checks for positive infinity. If `sign < 0`, checks for negative infinity.
+// If `sign == 0`, checks for either infinity.
+func IsInf(f float64, sign int) bool {
+	return sign >= 0 && f > MaxFloat64 || sign <= 0 && f < -MaxFloat64
+}
+
+// Reports whether `x` is negative or negative zero.
+func Signbit(x float64) bool {
+	return Float64bits(x)&signMask != 0
+}
+
+// Returns the absolute value of `x`.
+func Abs(x float64) float64 {
+	return Float64frombits(Float64bits(x) &^ signMask)
+}
+
+// Returns a value with the magnitude of `f` and the sign of `sign`.
+func Copysign(f, sign float64) float64 {
+	return Float64frombits(Float64bits(f)&^signMask | Float64bits(sign)&signMask)
+}
+
+// Returns the larger of `x` or `y`.
+//
+// Special cases are the same as in the math package:
+// infinity wins over NaN, NaN wins over numbers, and +0 is larger than -0.
+func Max(x, y float64) float64 {
+	switch {
+	case IsInf(x, 1) || IsInf(y, 1):
+		return Inf(1)
+	case IsNaN(x) || IsNaN(y):
+		return NaN()
+	case x == 0 && x == y:
+		if Signbit(x) {
+			return y
+		}
+		return x
+	case x > y:
+		return x
+	}
+	return y
+}
+
+// Returns the smaller of `x` or `y`.
+//
+// Special cases are the same as in the math package:
+// negative infinity wins over NaN, NaN wins over numbers, and -0 is smaller than +0.
+func Min(x, y float64) float64 {
+	switch {
+	case IsInf(x, -1) || IsInf(y, -1):
+		return Inf(-1)
+	case IsNaN(x) || IsNaN(y):
+		return NaN()
+	case x == 0 && x == y:
+		if Signbit(x) {
+			return x
+		}
+		return y
+	case x < y:
+		return x
+	}
+	return y
+}
+
+// Returns the maximum of `x-y` or `0`.
+func Dim(x, y float64) float64 {
+	v := x - y
+	if v <= 0 {
+		return 0
+	}
+	return v
+}
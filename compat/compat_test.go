@@ -0,0 +1,273 @@
+package compat_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/orsinium-labs/tinymath/compat"
+)
+
+var specials = []float64{
+	0, math.Copysign(0, -1), 1, -1, 0.5, -2.75, 1e300, -1e-300,
+	math.MaxFloat64, math.SmallestNonzeroFloat64,
+	math.Inf(1), math.Inf(-1), math.NaN(),
+}
+
+// Checks that the floats are identical, including the sign of zero and NaN.
+func same(t *testing.T, act, exp float64) {
+	t.Helper()
+	if math.IsNaN(act) && math.IsNaN(exp) {
+		return
+	}
+	if math.Float64bits(act) != math.Float64bits(exp) {
+		t.Fatalf("%g != %g", act, exp)
+	}
+}
+
+func TestConsts(t *testing.T) {
+	t.Parallel()
+	consts := []struct {
+		act, exp float64
+	}{
+		{compat.E, math.E},
+		{compat.Pi, math.Pi},
+		{compat.Phi, math.Phi},
+		{compat.Sqrt2, math.Sqrt2},
+		{compat.SqrtE, math.SqrtE},
+		{compat.SqrtPi, math.SqrtPi},
+		{compat.SqrtPhi, math.SqrtPhi},
+		{compat.Ln2, math.Ln2},
+		{compat.Log2E, math.Log2E},
+		{compat.Ln10, math.Ln10},
+		{compat.Log10E, math.Log10E},
+		{compat.MaxFloat32, math.MaxFloat32},
+		{compat.SmallestNonzeroFloat32, math.SmallestNonzeroFloat32},
+		{compat.MaxFloat64, math.MaxFloat64},
+		{compat.SmallestNonzeroFloat64, math.SmallestNonzeroFloat64},
+	}
+	for _, c := range consts {
+		same(t, c.act, c.exp)
+	}
+	if compat.MaxInt != math.MaxInt || compat.MinInt != math.MinInt {
+		t.Fatal("int limits")
+	}
+	if compat.MaxUint64 != math.MaxUint64 || compat.MinInt64 != math.MinInt64 {
+		t.Fatal("int64 limits")
+	}
+}
+
+func TestExact(t *testing.T) {
+	t.Parallel()
+	for _, x := range specials {
+		x := x
+		t.Run(fmt.Sprintf("%g", x), func(t *testing.T) {
+			same(t, compat.Abs(x), math.Abs(x))
+			if compat.IsNaN(x) != math.IsNaN(x) {
+				t.Fatal("IsNaN")
+			}
+			for _, sign := range []int{-1, 0, 1} {
+				if compat.IsInf(x, sign) != math.IsInf(x, sign) {
+					t.Fatalf("IsInf(%d)", sign)
+				}
+			}
+			if compat.Signbit(x) != math.Signbit(x) {
+				t.Fatal("Signbit")
+			}
+			if compat.Float64bits(x) != math.Float64bits(x) {
+				t.Fatal("Float64bits")
+			}
+			same(t, compat.Float64frombits(math.Float64bits(x)), x)
+			for _, y := range specials {
+				same(t, compat.Copysign(x, y), math.Copysign(x, y))
+				same(t, compat.Max(x, y), math.Max(x, y))
+				same(t, compat.Min(x, y), math.Min(x, y))
+				same(t, compat.Dim(x, y), math.Dim(x, y))
+			}
+		})
+	}
+	same(t, compat.Inf(1), math.Inf(1))
+	same(t, compat.Inf(-1), math.Inf(-1))
+	if !compat.IsNaN(compat.NaN()) {
+		t.Fatal("NaN")
+	}
+}
+
+// Values that are exactly representable as float32,
+// so that functions that are exact in float32 match math exactly.
+var samples32 = []float64{
+	0, math.Copysign(0, -1), 1, -1, 0.5, -0.5, 1.5, 2.5, -2.5, 3.75, -1234.5,
+	1e-30, 1e20, -1e20, 0x1p-140, math.MaxFloat32,
+	math.Inf(1), math.Inf(-1), math.NaN(),
+}
+
+func TestRounding(t *testing.T) {
+	t.Parallel()
+	for _, x := range samples32 {
+		x := float64(float32(x))
+		t.Run(fmt.Sprintf("%g", x), func(t *testing.T) {
+			same(t, compat.Floor(x), math.Floor(x))
+			same(t, compat.Ceil(x), math.Ceil(x))
+			same(t, compat.Trunc(x), math.Trunc(x))
+			same(t, compat.Round(x), math.Round(x))
+			same(t, compat.RoundToEven(x), math.RoundToEven(x))
+			i, f := compat.Modf(x)
+			ei, ef := math.Modf(x)
+			same(t, i, ei)
+			same(t, f, ef)
+		})
+	}
+}
+
+func TestDecompose(t *testing.T) {
+	t.Parallel()
+	for _, x := range samples32 {
+		x := float64(float32(x))
+		t.Run(fmt.Sprintf("%g", x), func(t *testing.T) {
+			frac, exp := compat.Frexp(x)
+			efrac, eexp := math.Frexp(x)
+			same(t, frac, efrac)
+			if exp != eexp {
+				t.Fatalf("exp: %d != %d", exp, eexp)
+			}
+			same(t, compat.Logb(x), math.Logb(x))
+			if compat.Ilogb(x) != math.Ilogb(x) {
+				t.Fatalf("Ilogb: %d != %d", compat.Ilogb(x), math.Ilogb(x))
+			}
+		})
+	}
+	same(t, compat.Ldexp(0.75, 10), 768)
+	same(t, compat.Ldexp(1, -149), float64(math.SmallestNonzeroFloat32))
+	same(t, compat.Ldexp(1, 1<<40), math.Inf(1))
+	same(t, compat.Ldexp(-1, -1<<40), math.Copysign(0, -1))
+	if compat.Nextafter32(1, 2) != math.Nextafter32(1, 2) {
+		t.Fatal("Nextafter32")
+	}
+}
+
+func TestMod(t *testing.T) {
+	t.Parallel()
+	xs := append([]float64{
+		5, -5, 3e38, -3e38, 1e300, 123456789.125, 0x1p-1060, 7e-320,
+	}, specials...)
+	ys := append([]float64{
+		0.75, -2.5, 7, 0.125, 0.1, 1e-10, -1e-300, 3, 1e-320, 0x1p-1074,
+	}, specials...)
+	for _, x := range xs {
+		for _, y := range ys {
+			act := compat.Mod(x, y)
+			exp := math.Mod(x, y)
+			if math.Float64bits(act) != math.Float64bits(exp) && !(math.IsNaN(act) && math.IsNaN(exp)) {
+				t.Fatalf("Mod(%g, %g) = %g, want %g", x, y, act, exp)
+			}
+		}
+	}
+	same(t, compat.Mod(-7, 7), math.Copysign(0, -1))
+
+	// Random quotients up to 2^2000, including subnormal remainders.
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 10_000; i++ {
+		x := rng.NormFloat64() * math.Pow(2, float64(rng.Intn(2000)-1000))
+		y := rng.NormFloat64() * math.Pow(2, float64(rng.Intn(2000)-1070))
+		act := compat.Mod(x, y)
+		exp := math.Mod(x, y)
+		if math.Float64bits(act) != math.Float64bits(exp) {
+			t.Fatalf("Mod(%g, %g) = %g, want %g", x, y, act, exp)
+		}
+	}
+}
+
+// The max errors documented in the package doc.
+func TestApproximations(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name   string
+		from   float64
+		to     float64
+		act    func(float64) float64
+		exp    func(float64) float64
+		maxAbs float64
+		maxRel float64
+	}{
+		{"Sin", -100, 100, compat.Sin, math.Sin, 1.1e-3, 0},
+		{"Cos", -100, 100, compat.Cos, math.Cos, 1.1e-3, 0},
+		{"Sincos", -100, 100, func(x float64) float64 {
+			s, c := compat.Sincos(x)
+			return s - c
+		}, func(x float64) float64 {
+			return math.Sin(x) - math.Cos(x)
+		}, 2.2e-3, 0},
+		{"Tan", -1.5, 1.5, compat.Tan, math.Tan, 0, 0.015},
+		{"Asin", -1, 1, compat.Asin, math.Asin, 5e-7, 0},
+		{"Acos", -1, 1, compat.Acos, math.Acos, 6e-7, 0},
+		{"Atan", -100, 100, compat.Atan, math.Atan, 2.8e-3, 0},
+		{"Atan2", -100, 100, func(x float64) float64 {
+			return compat.Atan2(x, -3)
+		}, func(x float64) float64 {
+			return math.Atan2(x, -3)
+		}, 2.8e-3, 0},
+		{"Exp", -80, 80, compat.Exp, math.Exp, 0, 6e-6},
+		{"Exp2", -126, 127, compat.Exp2, math.Exp2, 0, 3e-7},
+		{"Log", 1e-30, 1e30, compat.Log, math.Log, 0, 3e-7},
+		{"Log2", 1e-30, 1e30, compat.Log2, math.Log2, 0, 3e-7},
+		{"Log10", 1e-30, 1e30, compat.Log10, math.Log10, 0, 3e-7},
+		{"Log~1", 0.99, 1.01, compat.Log, math.Log, 0, 3e-7},
+		{"Log10~1", 0.99, 1.01, compat.Log10, math.Log10, 0, 3e-7},
+		{"Pow", 1e-3, 1e3, func(x float64) float64 {
+			return compat.Pow(x, 10.5)
+		}, func(x float64) float64 {
+			return math.Pow(x, 10.5)
+		}, 0, 2e-5},
+		{"Pow-", 1e-3, 1e3, func(x float64) float64 {
+			return compat.Pow(x, -10.5)
+		}, func(x float64) float64 {
+			return math.Pow(x, -10.5)
+		}, 0, 2e-5},
+		{"PowInt", 0.07, 14, func(x float64) float64 {
+			return compat.Pow(x, -33)
+		}, func(x float64) float64 {
+			return math.Pow(x, -33)
+		}, 0, 33 * 1.2e-7},
+		{"Sqrt", 1e-3, 1e3, compat.Sqrt, math.Sqrt, 0, 0.061},
+		{"Hypot", -100, 100, func(x float64) float64 {
+			return compat.Hypot(x, 3)
+		}, func(x float64) float64 {
+			return math.Hypot(x, 3)
+		}, 0, 0.061},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			const n = 10000
+			xs := make([]float64, 0, 2*n+2)
+			for i := 0; i <= n; i++ {
+				xs = append(xs, c.from+(c.to-c.from)*float64(i)/n)
+				// For positive ranges, also sample log-spaced values,
+				// linear steps skip everything close to the start.
+				if c.from > 0 {
+					xs = append(xs, c.from*math.Pow(c.to/c.from, float64(i)/n))
+				}
+			}
+			for _, x := range xs {
+				x := float64(float32(x))
+				act := c.act(x)
+				exp := c.exp(x)
+				diff := math.Abs(act - exp)
+				if c.maxAbs > 0 && diff > c.maxAbs*1.01 {
+					t.Fatalf("%s(%g) = %g, want %g", c.name, x, act, exp)
+				}
+				if c.maxRel > 0 && diff > c.maxRel*1.01*math.Abs(exp) {
+					t.Fatalf("%s(%g) = %g, want %g", c.name, x, act, exp)
+				}
+			}
+		})
+	}
+}
+
+func TestFMA(t *testing.T) {
+	t.Parallel()
+	same(t, compat.FMA(2, 3, 4), 10)
+	same(t, compat.FMA(0.1, 10, -1), float64(float32(math.FMA(float64(float32(0.1)), 10, -1))))
+}
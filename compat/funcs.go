@@ -0,0 +1,225 @@
+package compat
+
+import "github.com/orsinium-labs/tinymath"
+
+// Returns the sine of the radian argument `x`.
+func Sin(x float64) float64 {
+	return float64(tinymath.Sin(float32(x)))
+}
+
+// Returns the cosine of the radian argument `x`.
+func Cos(x float64) float64 {
+	return float64(tinymath.Cos(float32(x)))
+}
+
+// Returns `Sin(x), Cos(x)`.
+func Sincos(x float64) (sin, cos float64) {
+	s, c := tinymath.SinCos(float32(x))
+	return float64(s), float64(c)
+}
+
+// Returns the tangent of the radian argument `x`.
+func Tan(x float64) float64 {
+	return float64(tinymath.Tan(float32(x)))
+}
+
+// Returns the arcsine, in radians, of `x`.
+func Asin(x float64) float64 {
+	return float64(tinymath.Asin(float32(x)))
+}
+
+// Returns the arccosine, in radians, of `x`.
+func Acos(x float64) float64 {
+	return float64(tinymath.Acos(float32(x)))
+}
+
+// Returns the arctangent, in radians, of `x`.
+func Atan(x float64) float64 {
+	return float64(tinymath.Atan(float32(x)))
+}
+
+// Returns the arc tangent of `y/x`, using the signs of the two
+// to determine the quadrant of the return value.
+func Atan2(y, x float64) float64 {
+	return float64(tinymath.Atan2(float32(y), float32(x)))
+}
+
+// Returns `Sqrt(p*p + q*q)`.
+func Hypot(p, q float64) float64 {
+	return float64(tinymath.Hypot(float32(p), float32(q)))
+}
+
+// Returns the square root of `x`.
+func Sqrt(x float64) float64 {
+	return float64(tinymath.Sqrt(float32(x)))
+}
+
+// Returns `e**x`, the base-e exponential of `x`.
+func Exp(x float64) float64 {
+	return float64(tinymath.Exp(float32(x)))
+}
+
+// Returns `2**x`, the base-2 exponential of `x`.
+func Exp2(x float64) float64 {
+	return float64(tinymath.Exp2(float32(x)))
+}
+
+// Returns the natural logarithm of `x`.
+func Log(x float64) float64 {
+	return float64(tinymath.Ln(float32(x)))
+}
+
+// Returns the binary logarithm of `x`.
+func Log2(x float64) float64 {
+	return float64(tinymath.Log2(float32(x)))
+}
+
+// Returns the decimal logarithm of `x`.
+func Log10(x float64) float64 {
+	return float64(tinymath.Log10(float32(x)))
+}
+
+// Returns `x**y`, the base-x exponential of `y`.
+func Pow(x, y float64) float64 {
+	return float64(tinymath.PowF(float32(x), float32(y)))
+}
+
+// Returns `x*y + z`, computed with only one rounding (in float32).
+func FMA(x, y, z float64) float64 {
+	return float64(tinymath.FMA(float32(x), float32(y), float32(z)))
+}
+
+// Returns the greatest integer value less than or equal to `x`.
+func Floor(x float64) float64 {
+	return float64(tinymath.Floor(float32(x)))
+}
+
+// Returns the least integer value greater than or equal to `x`.
+func Ceil(x float64) float64 {
+	return float64(tinymath.Ceil(float32(x)))
+}
+
+// Returns the integer value of `x`.
+func Trunc(x float64) float64 {
+	return float64(tinymath.Trunc(float32(x)))
+}
+
+// Returns the nearest integer, rounding half away from zero.
+func Round(x float64) float64 {
+	return float64(tinymath.Round(float32(x)))
+}
+
+// Returns the nearest integer, rounding ties to even.
+func RoundToEven(x float64) float64 {
+	return float64(tinymath.RoundToEven(float32(x)))
+}
+
+// Returns integer and fractional floating-point numbers that sum to `f`.
+// Both values have the same sign as `f`.
+func Modf(f float64) (int float64, frac float64) {
+	i, fr := tinymath.Modf(float32(f))
+	return float64(i), float64(fr)
+}
+
+// Returns the floating-point remainder of `x/y`.
+// The result has the sign of `x` and its magnitude is less than the magnitude of `y`.
+//
+// Special cases are the same as in the math package: the result is NaN
+// if `x` is infinite or NaN, or if `y` is zero or NaN. It is `x` if `y` is infinite.
+//
+// Unlike most functions in this package, it works on float64 directly.
+// The remainder is always exact, so the result is the same as of math.Mod.
+func Mod(x, y float64) float64 {
+	if y == 0 || IsInf(x, 0) || IsNaN(x) || IsNaN(y) {
+		return NaN()
+	}
+	if Abs(x) < Abs(y) {
+		// Also covers the infinite y.
+		return x
+	}
+	mx, ex := splitFloat64(Abs(x))
+	my, ey := splitFloat64(Abs(y))
+	// Long division, one bit of the quotient at a time. Both mantissas
+	// have 53 bits and mx stays less than 2*my, so nothing overflows
+	// and each subtraction is exact.
+	for ; ex > ey; ex-- {
+		if mx >= my {
+			mx -= my
+		}
+		mx <<= 1
+	}
+	if mx >= my {
+		mx -= my
+	}
+	return Copysign(joinFloat64(mx, ey), x)
+}
+
+// Splits a positive finite float64 into the mantissa and the exponent,
+// so that `x == m × 2**(e-1075)` and `m` has the highest (53rd) bit set.
+func splitFloat64(x float64) (m uint64, e int) {
+	const implicit = 1 << 52
+	b := Float64bits(x)
+	e = int(b >> 52)
+	m = b & (implicit - 1)
+	if e != 0 {
+		return m | implicit, e
+	}
+	// Subnormal numbers have the same scale as the smallest exponent.
+	e = 1
+	for m&implicit == 0 {
+		m <<= 1
+		e--
+	}
+	return m, e
+}
+
+// The inverse of [splitFloat64] for mantissas that fit into 53 bits.
+// Returns a subnormal number (or zero) if the exponent is too small.
+func joinFloat64(m uint64, e int) float64 {
+	const implicit = 1 << 52
+	if m == 0 {
+		return 0
+	}
+	for m&implicit == 0 && e > 1 {
+		m <<= 1
+		e--
+	}
+	if e < 1 || m&implicit == 0 {
+		// The exponent bits of subnormal numbers are zero.
+		return Float64frombits(m >> (1 - e))
+	}
+	return Float64frombits(uint64(e)<<52 | m&^implicit)
+}
+
+// Breaks `f` into a normalized fraction and an integral power of two.
+//
+// Returns `frac` and `exp` satisfying `f == frac × 2**exp`,
+// with the absolute value of `frac` in the interval `[½, 1)`.
+func Frexp(f float64) (frac float64, exp int) {
+	fr, e := tinymath.Frexp(float32(f))
+	return float64(fr), int(e)
+}
+
+// The inverse of [Frexp]. Returns `frac × 2**exp`.
+func Ldexp(frac float64, exp int) float64 {
+	// Exponents outside of int32 saturate the same way as the ones that are just out of range.
+	e := int32(max(min(exp, MaxInt32), MinInt32))
+	return float64(tinymath.Ldexp(float32(frac), e))
+}
+
+// Returns the binary exponent of `x`.
+func Logb(x float64) float64 {
+	return float64(tinymath.Logb(float32(x)))
+}
+
+// Returns the binary exponent of `x` as an integer.
+func Ilogb(x float64) int {
+	return int(tinymath.Ilogb(float32(x)))
+}
+
+// Returns the next representable float32 value after `x` towards `y`.
+//
+// This one is exact, since it works on float32 anyway.
+func Nextafter32(x, y float32) float32 {
+	return tinymath.Nextafter(x, y)
+}
@@ -0,0 +1,293 @@
+// Package cordic implements the CORDIC algorithm in fixed point
+// for microcontrollers without FPU.
+//
+// CORDIC computes trigonometric and hyperbolic functions using only
+// additions, shifts, and a small table, with one bit of precision per iteration.
+// Every function accepts the number of iterations, up to [MaxIterations],
+// to trade precision for speed.
+//
+// Fixed-point values are Q2.30: int32 with 30 fractional bits, where [One] is 1.0.
+// Angles are binary angles: a full turn is 2^32, so [HalfTurn] is π
+// and angles wrap around naturally on integer overflow.
+//
+// [Float] provides float32 wrappers with the same signatures as tinymath functions.
+package cordic
+
+import "github.com/orsinium-labs/tinymath"
+
+// 1.0 in Q2.30.
+const One int32 = 1 << 30
+
+// A quarter of a turn (π/2) as a binary angle.
+const QuarterTurn int32 = 1 << 30
+
+// Half a turn (π) as a binary angle.
+//
+// It's the same as -π and the smallest int32.
+const HalfTurn int32 = -1 << 31
+
+// The max number of iterations.
+//
+// After that, the table values become zero and more iterations don't change the result.
+const MaxIterations = 30
+
+// The number of iterations that gives the best precision possible with Q2.30.
+const DefaultIterations = 28
+
+// atan(2^-i) as binary angles.
+var atanTable = [MaxIterations]int32{
+	536870912, 316933406, 167458907, 85004756, 42667331, 21354465, 10679838, 5340245,
+	2670163, 1335087, 667544, 333772, 166886, 83443, 41722, 20861,
+	10430, 5215, 2608, 1304, 652, 326, 163, 81,
+	41, 20, 10, 5, 3, 1,
+}
+
+// 1/K in Q2.30, where K is the gain of the circular CORDIC after n+1 iterations.
+var invGain = [MaxIterations]int32{
+	759250125, 679093957, 658817909, 653730436, 652457347, 652138997, 652059405, 652039507,
+	652034532, 652033289, 652032978, 652032900, 652032881, 652032876, 652032874, 652032874,
+	652032874, 652032874, 652032874, 652032874, 652032874, 652032874, 652032874, 652032874,
+	652032874, 652032874, 652032874, 652032874, 652032874, 652032874,
+}
+
+// atanh(2^-i) in Q2.30. The hyperbolic CORDIC starts from i=1.
+var atanhTable = [MaxIterations + 1]int32{
+	0, 589812981, 274247419, 134923406, 67196451, 33565361, 16778582, 8388779,
+	4194325, 2097155, 1048576, 524288, 262144, 131072, 65536, 32768,
+	16384, 8192, 4096, 2048, 1024, 512, 256, 128,
+	64, 32, 16, 8, 4, 2, 1,
+}
+
+// 1/K in Q2.30, where K is the gain of the hyperbolic CORDIC after n+1 iterations.
+var invGainHyperbolic = [MaxIterations]int32{
+	1239850262, 1280511845, 1290634625, 1293162805, 1295695938, 1296329066, 1296487338, 1296526905,
+	1296536797, 1296539270, 1296539888, 1296540043, 1296540081, 1296540091, 1296540101, 1296540103,
+	1296540104, 1296540104, 1296540104, 1296540104, 1296540104, 1296540104, 1296540104, 1296540104,
+	1296540104, 1296540104, 1296540104, 1296540104, 1296540104, 1296540104,
+}
+
+const (
+	// ln(2) in Q2.30.
+	ln2 int64 = 744261118
+	// log2(e) in Q2.30.
+	log2e int64 = 1549082005
+	// sqrt(2) in Q2.30.
+	sqrt2 int32 = 1518500250
+)
+
+// Computes sine and cosine of a binary angle. Results are Q2.30.
+func SinCos(angle int32, iterations int) (sin, cos int32) {
+	n := clampIterations(iterations)
+	// CORDIC converges only for angles in [-π/2, π/2].
+	// For other angles, rotate by π and negate the result.
+	flip := angle > QuarterTurn || angle < -QuarterTurn
+	if flip {
+		angle -= HalfTurn
+	}
+	x, y := rotate(invGain[n-1], 0, angle, n)
+	if flip {
+		return -y, -x
+	}
+	return y, x
+}
+
+// Computes the angle between the positive x axis and the point `(x, y)`.
+//
+// The result is a binary angle in `[-π, π)`. Returns zero if both values are zero.
+// The values can be of any scale.
+func Atan2(y, x int32, iterations int) int32 {
+	if x == 0 && y == 0 {
+		return 0
+	}
+	x, y, _ = normalize(x, y)
+	_, angle := vector(x, y, clampIterations(iterations))
+	return angle
+}
+
+// Computes `sqrt(x*x + y*y)` without overflows.
+//
+// The result is in the same units as the inputs and saturates at the max int32.
+func Hypot(x, y int32, iterations int) int32 {
+	if x == 0 && y == 0 {
+		return 0
+	}
+	n := clampIterations(iterations)
+	x, y, shift := normalize(x, y)
+	r, _ := vector(x, y, n)
+	h := (int64(r)*int64(invGain[n-1]) + 1<<29) >> 30
+	if shift > 0 {
+		h = (h + 1<<(shift-1)) >> shift
+	} else {
+		h <<= -shift
+	}
+	return int32(min(h, 1<<31-1))
+}
+
+// Computes `e^x`. Both the argument and the result are Q16.16.
+//
+// The result saturates at the max int32 (about 32768.0).
+func Exp(x int32, iterations int) int32 {
+	n := clampIterations(iterations)
+	// x = k*ln(2) + r, where r is in [-ln(2)/2, ln(2)/2].
+	xq := int64(x) << 14
+	k := (int64(x)*log2e + 1<<45) >> 46
+	r := xq - k*ln2
+	// e^x = 2^k * e^r. e^r is in Q2.30 and the result is Q16.16.
+	e := int64(expCore(int32(r), n))
+	shift := k - 14
+	switch {
+	case shift >= 32:
+		return 1<<31 - 1
+	case shift >= 0:
+		return int32(min(e<<shift, 1<<31-1))
+	case shift > -62:
+		return int32((e + 1<<(-shift-1)) >> -shift)
+	default:
+		return 0
+	}
+}
+
+// Computes the natural logarithm. Both the argument and the result are Q16.16.
+//
+// Returns the min int32 for zero and negative numbers.
+func Ln(x int32, iterations int) int32 {
+	if x <= 0 {
+		return -1 << 31
+	}
+	n := clampIterations(iterations)
+	// x = 2^k * m, where m is in [sqrt(2)/2, sqrt(2)).
+	k := tinymath.ILog2(x)
+	m := x << (30 - k)
+	if m > sqrt2 {
+		m >>= 1
+		k++
+	}
+	// ln(x) = (k-16)*ln(2) + ln(m), converted from Q2.30 to Q16.16.
+	l := int64(k-16)*ln2 + int64(lnCore(m, n))
+	return int32((l + 1<<13) >> 14)
+}
+
+// Computes `e^r` for `r` in about `[-1.1, 1.1]`. Both values are Q2.30.
+func expCore(r int32, n int) int32 {
+	x, y := rotateHyperbolic(invGainHyperbolic[n-1], 0, r, n)
+	// cosh(r) + sinh(r) = e^r
+	return x + y
+}
+
+// Computes `ln(m)` for `m` in `[sqrt(2)/2, sqrt(2)]`. Both values are Q2.30.
+func lnCore(m int32, n int) int32 {
+	// ln(m) = 2*atanh((m-1)/(m+1)). Both parts are halved to not overflow.
+	z := vectorHyperbolic((m>>1)+(One>>1), (m>>1)-(One>>1), n)
+	return 2 * z
+}
+
+// Rotates the vector `(x, y)` by the binary angle `z` in `[-π/2, π/2]`.
+//
+// The result is scaled by the CORDIC gain.
+func rotate(x, y, z int32, n int) (int32, int32) {
+	for i := 0; i < n; i++ {
+		dx := y >> i
+		dy := x >> i
+		if z >= 0 {
+			x, y, z = x-dx, y+dy, z-atanTable[i]
+		} else {
+			x, y, z = x+dx, y-dy, z+atanTable[i]
+		}
+	}
+	return x, y
+}
+
+// Rotates the vector `(x, y)` onto the x axis.
+//
+// Returns its length scaled by the CORDIC gain and its angle.
+//
+// Both values must be normalized, see [normalize].
+func vector(x, y int32, n int) (int32, int32) {
+	var z int32
+	if x < 0 {
+		x, y = -x, -y
+		z = HalfTurn
+	}
+	for i := 0; i < n; i++ {
+		dx := y >> i
+		dy := x >> i
+		if y < 0 {
+			x, y, z = x-dx, y+dy, z-atanTable[i]
+		} else {
+			x, y, z = x+dx, y-dy, z+atanTable[i]
+		}
+	}
+	return x, z
+}
+
+// The hyperbolic rotation mode.
+//
+// Iterations start from i=1 and the iterations 4, 13, 40, ... are repeated,
+// otherwise the algorithm doesn't converge.
+func rotateHyperbolic(x, y, z int32, n int) (int32, int32) {
+	i, next, repeated := 1, 4, false
+	for k := 0; k < n; k++ {
+		dx := y >> i
+		dy := x >> i
+		if z >= 0 {
+			x, y, z = x+dx, y+dy, z-atanhTable[i]
+		} else {
+			x, y, z = x-dx, y-dy, z+atanhTable[i]
+		}
+		i, next, repeated = nextHyperbolic(i, next, repeated)
+	}
+	return x, y
+}
+
+// The hyperbolic vectoring mode, returns `atanh(y/x)`.
+func vectorHyperbolic(x, y int32, n int) int32 {
+	var z int32
+	i, next, repeated := 1, 4, false
+	for k := 0; k < n; k++ {
+		dx := y >> i
+		dy := x >> i
+		if y < 0 {
+			x, y, z = x+dx, y+dy, z-atanhTable[i]
+		} else {
+			x, y, z = x-dx, y-dy, z+atanhTable[i]
+		}
+		i, next, repeated = nextHyperbolic(i, next, repeated)
+	}
+	return z
+}
+
+// Returns the next index of the hyperbolic CORDIC iteration.
+func nextHyperbolic(i, next int, repeated bool) (int, int, bool) {
+	if i == next && !repeated {
+		return i, next, true
+	}
+	if i == next {
+		next = 3*next + 1
+	}
+	return i + 1, next, false
+}
+
+// Scales both values by the same power of two so that the largest of them
+// is in `[2^28, 2^29)`. It keeps the precision and doesn't let the gain overflow int32.
+//
+// Returns the scaled values and the power of two they were multiplied by.
+func normalize(x, y int32) (int32, int32, int32) {
+	m := max(abs(x), abs(y))
+	shift := 28 - tinymath.ILog2(m)
+	if shift >= 0 {
+		return x << shift, y << shift, shift
+	}
+	return x >> -shift, y >> -shift, shift
+}
+
+// Returns the absolute value as int64, so that it doesn't overflow for the min int32.
+func abs(x int32) int64 {
+	if x < 0 {
+		return -int64(x)
+	}
+	return int64(x)
+}
+
+func clampIterations(n int) int {
+	return min(max(n, 1), MaxIterations)
+}
@@ -0,0 +1,168 @@
+package cordic_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/orsinium-labs/tinymath/cordic"
+)
+
+const q30 = 1 << 30
+
+// Converts radians into a binary angle.
+func binAngle(rad float64) int32 {
+	turns := rad / (2 * math.Pi)
+	turns -= math.Floor(turns)
+	return int32(uint32(int64(turns * (1 << 32))))
+}
+
+func TestSinCos(t *testing.T) {
+	t.Parallel()
+	for i := -1000; i <= 1000; i++ {
+		rad := float64(i) * 0.01
+		s, c := cordic.SinCos(binAngle(rad), cordic.DefaultIterations)
+		if d := math.Abs(float64(s)/q30 - math.Sin(rad)); d > 3e-8 {
+			t.Fatalf("sin(%f): error %g", rad, d)
+		}
+		if d := math.Abs(float64(c)/q30 - math.Cos(rad)); d > 3e-8 {
+			t.Fatalf("cos(%f): error %g", rad, d)
+		}
+	}
+}
+
+func TestSinCosEdges(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		angle    int32
+		sin, cos float64
+	}{
+		{0, 0, 1},
+		{cordic.QuarterTurn, 1, 0},
+		{-cordic.QuarterTurn, -1, 0},
+		{cordic.HalfTurn, 0, -1},
+		{cordic.QuarterTurn + 1, 1, 0},
+		{cordic.QuarterTurn / 2, math.Sqrt2 / 2, math.Sqrt2 / 2},
+	}
+	for _, c := range cases {
+		s, co := cordic.SinCos(c.angle, cordic.DefaultIterations)
+		if math.Abs(float64(s)/q30-c.sin) > 3e-8 || math.Abs(float64(co)/q30-c.cos) > 3e-8 {
+			t.Fatalf("%d: (%d, %d)", c.angle, s, co)
+		}
+	}
+}
+
+// Each iteration adds about one bit of precision.
+func TestIterations(t *testing.T) {
+	t.Parallel()
+	prev := math.Inf(1)
+	for _, n := range []int{4, 8, 12, 16, 20, 24} {
+		var maxErr float64
+		for i := -314; i <= 314; i++ {
+			rad := float64(i) * 0.01
+			s, _ := cordic.SinCos(binAngle(rad), n)
+			maxErr = math.Max(maxErr, math.Abs(float64(s)/q30-math.Sin(rad)))
+		}
+		bound := 2 * math.Pow(2, -float64(n)+1)
+		if maxErr > bound || maxErr > prev {
+			t.Fatalf("%d iterations: error %g, bound %g, previous %g", n, maxErr, bound, prev)
+		}
+		prev = maxErr
+	}
+	// Out of range iteration counts are clamped.
+	s0, _ := cordic.SinCos(cordic.QuarterTurn/3, 0)
+	s1, _ := cordic.SinCos(cordic.QuarterTurn/3, 1)
+	if s0 != s1 {
+		t.Fatalf("%d != %d", s0, s1)
+	}
+	s0, _ = cordic.SinCos(cordic.QuarterTurn/3, 100)
+	s1, _ = cordic.SinCos(cordic.QuarterTurn/3, cordic.MaxIterations)
+	if s0 != s1 {
+		t.Fatalf("%d != %d", s0, s1)
+	}
+}
+
+func TestAtan2(t *testing.T) {
+	t.Parallel()
+	for _, scale := range []float64{1e-6, 1, 1 << 20, 1 << 30} {
+		for i := 0; i < 360; i += 5 {
+			rad := float64(i) * math.Pi / 180
+			x := int32(math.Cos(rad) * scale * 1000)
+			y := int32(math.Sin(rad) * scale * 1000)
+			if x == 0 && y == 0 {
+				continue
+			}
+			exp := math.Atan2(float64(y), float64(x))
+			act := float64(cordic.Atan2(y, x, cordic.DefaultIterations)) * math.Pi / (1 << 31)
+			d := math.Abs(math.Remainder(act-exp, 2*math.Pi))
+			if d > 1e-7 {
+				t.Fatalf("atan2(%d, %d): %f != %f", y, x, act, exp)
+			}
+		}
+	}
+	if a := cordic.Atan2(0, 0, cordic.DefaultIterations); a != 0 {
+		t.Fatalf("atan2(0, 0) = %d", a)
+	}
+	// A few units from π, which is the same as -π.
+	if a := cordic.Atan2(0, -5, cordic.DefaultIterations); a-cordic.HalfTurn > 16 || cordic.HalfTurn-a > 16 {
+		t.Fatalf("atan2(0, -5) = %d", a)
+	}
+}
+
+func TestHypot(t *testing.T) {
+	t.Parallel()
+	cases := [][3]int32{
+		{3, 4, 5},
+		{-3, 4, 5},
+		{0, 0, 0},
+		{300_000, 400_000, 500_000},
+		{3 * q30 / 8, 4 * q30 / 8, 5 * q30 / 8},
+		{-1 << 31, 0, 1<<31 - 1},
+		{1<<31 - 1, 1<<31 - 1, 1<<31 - 1},
+		{1 << 30, 1 << 30, 1518500250},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("%d_%d", c[0], c[1]), func(t *testing.T) {
+			act := cordic.Hypot(c[0], c[1], cordic.DefaultIterations)
+			d := math.Abs(float64(act) - float64(c[2]))
+			if d > 4+float64(c[2])*2e-8 {
+				t.Fatalf("%d != %d", act, c[2])
+			}
+		})
+	}
+}
+
+func TestExpLn(t *testing.T) {
+	t.Parallel()
+	const q16 = 1 << 16
+	for i := -110; i <= 103; i++ {
+		xq := int32(float64(i) / 10 * q16)
+		x := float64(xq) / q16
+		act := float64(cordic.Exp(xq, cordic.DefaultIterations)) / q16
+		exp := math.Exp(x)
+		if d := math.Abs(act - exp); d > 2.0/q16+exp*1e-7 {
+			t.Fatalf("exp(%f): %f != %f", x, act, exp)
+		}
+	}
+	if e := cordic.Exp(11*q16, cordic.DefaultIterations); e != 1<<31-1 {
+		t.Fatalf("exp(11) = %d", e)
+	}
+	if e := cordic.Exp(-1<<31, cordic.DefaultIterations); e != 0 {
+		t.Fatalf("exp(-32768) = %d", e)
+	}
+
+	for _, x := range []int32{1, 2, 100, q16 / 3, q16, q16 + 1, 3 * q16, 1000 * q16, 1<<31 - 1} {
+		act := float64(cordic.Ln(x, cordic.DefaultIterations)) / q16
+		exp := math.Log(float64(x) / q16)
+		if d := math.Abs(act - exp); d > 1.0/q16 {
+			t.Fatalf("ln(%d): %f != %f", x, act, exp)
+		}
+	}
+	if l := cordic.Ln(0, cordic.DefaultIterations); l != -1<<31 {
+		t.Fatalf("ln(0) = %d", l)
+	}
+	if l := cordic.Ln(-1, cordic.DefaultIterations); l != -1<<31 {
+		t.Fatalf("ln(-1) = %d", l)
+	}
+}
@@ -0,0 +1,148 @@
+package cordic
+
+import "github.com/orsinium-labs/tinymath"
+
+// Float32 wrappers for CORDIC functions.
+//
+// Methods have the same signatures as the matching tinymath functions,
+// so they can be swapped in, like `sin := cordic.Default.Sin`.
+type Float struct {
+	// The number of CORDIC iterations, see [MaxIterations].
+	Iterations int
+}
+
+// [Float] with [DefaultIterations].
+var Default = Float{Iterations: DefaultIterations}
+
+// Approximates `sin(x)` in radians.
+func (f Float) Sin(x float32) float32 {
+	s, _ := f.SinCos(x)
+	return s
+}
+
+// Approximates `cos(x)` in radians.
+func (f Float) Cos(x float32) float32 {
+	_, c := f.SinCos(x)
+	return c
+}
+
+// Simultaneously computes the sine and cosine of the number, `x`.
+// Returns `(sin(x), cos(x))`.
+//
+// Returns NaN for infinities and NaN.
+func (f Float) SinCos(x float32) (float32, float32) {
+	turns := x * (1 / tinymath.Tau)
+	turns -= tinymath.Floor(turns)
+	// The negated condition also catches NaN and infinities.
+	if !(turns >= 0 && turns <= 1) {
+		return tinymath.NaN, tinymath.NaN
+	}
+	angle := int32(uint32(int64(turns * 0x1p32)))
+	s, c := SinCos(angle, f.Iterations)
+	return fromQ30(s), fromQ30(c)
+}
+
+// Approximates `tan(x)` in radians.
+func (f Float) Tan(x float32) float32 {
+	s, c := f.SinCos(x)
+	return s / c
+}
+
+// Computes the angle in radians between the positive x axis and the point `(rhs, self)`.
+//
+// Returns NaN if any of the values is NaN or infinity.
+func (f Float) Atan2(self float32, rhs float32) float32 {
+	y, x, _, ok := toFixedPair(self, rhs)
+	if !ok {
+		return tinymath.NaN
+	}
+	angle := Atan2(y, x, f.Iterations)
+	return float32(angle) * (tinymath.Pi / 0x1p31)
+}
+
+// Calculates the length of the hypotenuse of a right-angle triangle
+// given legs of length `self` and `rhs`.
+func (f Float) Hypot(self float32, rhs float32) float32 {
+	x, y, exp, ok := toFixedPair(self, rhs)
+	if !ok {
+		if tinymath.Abs(self) == tinymath.Inf || tinymath.Abs(rhs) == tinymath.Inf {
+			return tinymath.Inf
+		}
+		return tinymath.NaN
+	}
+	h := Hypot(x, y, f.Iterations)
+	return tinymath.Ldexp(float32(h), exp)
+}
+
+// Returns `e^(self)`, (the exponential function).
+func (f Float) Exp(self float32) float32 {
+	if tinymath.IsNaN(self) {
+		return self
+	}
+	// self = k*ln(2) + r, where r is in [-ln(2)/2, ln(2)/2].
+	// ln(2) is split into two parts to keep r precise for big k.
+	const ln2Hi = 0.693145751953125
+	const ln2Lo = 1.428606765330187e-06
+	k := tinymath.Round(self * tinymath.Log2E)
+	if k > 128 {
+		return tinymath.Inf
+	}
+	if k < -150 {
+		return 0
+	}
+	r := self - k*ln2Hi - k*ln2Lo
+	e := expCore(toQ30(r), clampIterations(f.Iterations))
+	return tinymath.Ldexp(fromQ30(e), int32(k))
+}
+
+// Returns the natural logarithm of the number.
+//
+// Returns NaN for negative numbers and -Inf for zero.
+func (f Float) Ln(self float32) float32 {
+	switch {
+	case tinymath.IsNaN(self) || self < 0:
+		return tinymath.NaN
+	case self == 0:
+		return tinymath.NegInf
+	case self == tinymath.Inf:
+		return self
+	}
+	// self = 2^k * m, where m is in [sqrt(2)/2, sqrt(2)).
+	m, k := tinymath.Frexp(self)
+	if m < tinymath.Frac1Sqrt2 {
+		m *= 2
+		k--
+	}
+	l := lnCore(toQ30(m), clampIterations(f.Iterations))
+	return fromQ30(l) + float32(k)*tinymath.Ln2
+}
+
+// Converts a pair of floats into fixed-point values with the same scale.
+//
+// Returns the values and the exponent of the scale, `a == fa × 2^exp`.
+// The last return value is false if any of the values is NaN or infinity.
+func toFixedPair(a, b float32) (int32, int32, int32, bool) {
+	if tinymath.IsNaN(a) || tinymath.IsNaN(b) {
+		return 0, 0, 0, false
+	}
+	m := tinymath.Max(tinymath.Abs(a), tinymath.Abs(b))
+	if m == tinymath.Inf {
+		return 0, 0, 0, false
+	}
+	if m == 0 {
+		return 0, 0, 0, true
+	}
+	// Scale the largest value into [2^28, 2^29), the same as [normalize] does.
+	// It keeps 28 significant bits and leaves room for the CORDIC gain (~1.65)
+	// and the sum of both values without overflowing int32.
+	exp := tinymath.Ilogb(m) - 28
+	return int32(tinymath.Ldexp(a, -exp)), int32(tinymath.Ldexp(b, -exp)), exp, true
+}
+
+func toQ30(x float32) int32 {
+	return int32(x * 0x1p30)
+}
+
+func fromQ30(x int32) float32 {
+	return float32(x) * 0x1p-30
+}
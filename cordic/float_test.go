@@ -0,0 +1,106 @@
+package cordic_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/orsinium-labs/tinymath"
+	"github.com/orsinium-labs/tinymath/cordic"
+)
+
+func close(t *testing.T, act, exp float32, eps float32) {
+	t.Helper()
+	if tinymath.IsNaN(exp) && !tinymath.IsNaN(act) {
+		t.Fatalf("%f is not NaN", act)
+	}
+	delta := tinymath.Abs(act - exp)
+	if delta > eps {
+		t.Fatalf("%f != %f", act, exp)
+	}
+}
+
+// The float32 wrappers can be used in place of tinymath functions.
+var (
+	_ func(float32) float32            = cordic.Default.Sin
+	_ func(float32) float32            = cordic.Default.Cos
+	_ func(float32) (float32, float32) = cordic.Default.SinCos
+	_ func(float32, float32) float32   = cordic.Default.Atan2
+	_ func(float32, float32) float32   = cordic.Default.Hypot
+	_ func(float32) float32            = cordic.Default.Exp
+	_ func(float32) float32            = cordic.Default.Ln
+)
+
+func TestFloatTrig(t *testing.T) {
+	t.Parallel()
+	f := cordic.Default
+	for i := -100; i <= 100; i++ {
+		x := float32(i) * 0.1
+		x64 := float64(x)
+		// Converting radians into turns in float32 loses a few bits.
+		close(t, f.Sin(x), float32(math.Sin(x64)), 1e-6)
+		close(t, f.Cos(x), float32(math.Cos(x64)), 1e-6)
+		if math.Abs(math.Cos(x64)) > 0.1 {
+			exp := float32(math.Tan(x64))
+			close(t, f.Tan(x), exp, 1e-5*tinymath.Max(1, tinymath.Abs(exp)))
+		}
+	}
+	close(t, f.Sin(tinymath.NaN), tinymath.NaN, 0)
+	close(t, f.Cos(tinymath.Inf), tinymath.NaN, 0)
+}
+
+func TestFloatAtan2Hypot(t *testing.T) {
+	t.Parallel()
+	f := cordic.Default
+	for _, scale := range []float32{1e-30, 1e-3, 1, 1e3, 1e30} {
+		for i := 0; i < 360; i += 15 {
+			rad := float64(i) * math.Pi / 180
+			x := float32(math.Cos(rad)) * scale * 3
+			y := float32(math.Sin(rad)) * scale * 3
+			t.Run(fmt.Sprintf("%g_%g", y, x), func(t *testing.T) {
+				exp := math.Atan2(float64(y), float64(x))
+				act := float64(f.Atan2(y, x))
+				if math.Abs(math.Remainder(act-exp, 2*math.Pi)) > 3e-7 {
+					t.Fatalf("atan2: %f != %f", act, exp)
+				}
+				h := math.Hypot(float64(x), float64(y))
+				close(t, f.Hypot(x, y), float32(h), float32(h)*2e-7)
+			})
+		}
+	}
+	close(t, f.Atan2(0, 0), 0, 0)
+	close(t, f.Hypot(0, 0), 0, 0)
+	close(t, f.Atan2(tinymath.NaN, 1), tinymath.NaN, 0)
+	close(t, f.Hypot(tinymath.NaN, 1), tinymath.NaN, 0)
+	close(t, f.Hypot(tinymath.NegInf, 1), tinymath.Inf, 0)
+}
+
+func TestFloatExpLn(t *testing.T) {
+	t.Parallel()
+	f := cordic.Default
+	for i := -870; i <= 880; i++ {
+		x := float32(i) / 10
+		exp := float32(math.Exp(float64(x)))
+		close(t, f.Exp(x), exp, exp*5e-7)
+	}
+	close(t, f.Exp(100), tinymath.Inf, 0)
+	close(t, f.Exp(-200), 0, 0)
+	close(t, f.Exp(tinymath.NaN), tinymath.NaN, 0)
+
+	for _, x := range []float32{1e-40, 1e-20, 0.1, 0.5, 0.7, 1, 1.4, 2, 10, 12345, 1e30} {
+		exp := float32(math.Log(float64(x)))
+		close(t, f.Ln(x), exp, 3e-7*tinymath.Max(1, tinymath.Abs(exp)))
+	}
+	close(t, f.Ln(0), tinymath.NegInf, 0)
+	close(t, f.Ln(-1), tinymath.NaN, 0)
+	close(t, f.Ln(tinymath.Inf), tinymath.Inf, 0)
+}
+
+// Fewer iterations are faster but less precise.
+func TestFloatIterations(t *testing.T) {
+	t.Parallel()
+	f := cordic.Float{Iterations: 12}
+	close(t, f.Sin(1), float32(math.Sin(1)), 1e-3)
+	close(t, f.Exp(1), tinymath.E, 1e-3)
+	close(t, f.Ln(10), float32(math.Log(10)), 1e-3)
+}
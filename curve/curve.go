@@ -0,0 +1,151 @@
+package curve
+
+// The maximum recursion depth for adaptive subdivision.
+//
+// 2^16 segments per curve is more than enough for any sane tolerance
+// and it guarantees that a zero or NaN tolerance doesn't overflow the stack.
+const maxDepth = 16
+
+// Evaluates the quadratic Bezier curve at `t` in the `[0, 1]` range.
+func QuadBezier[P Point[P]](p0, p1, p2 P, t float32) P {
+	u := 1 - t
+	return p0.Scale(u * u).Add(p1.Scale(2 * u * t)).Add(p2.Scale(t * t))
+}
+
+// Evaluates the derivative (tangent) of the quadratic Bezier curve at `t`.
+func QuadBezierDeriv[P Point[P]](p0, p1, p2 P, t float32) P {
+	u := 1 - t
+	return p1.Sub(p0).Scale(2 * u).Add(p2.Sub(p1).Scale(2 * t))
+}
+
+// Evaluates the cubic Bezier curve at `t` in the `[0, 1]` range.
+func CubicBezier[P Point[P]](p0, p1, p2, p3 P, t float32) P {
+	u := 1 - t
+	return p0.Scale(u * u * u).
+		Add(p1.Scale(3 * u * u * t)).
+		Add(p2.Scale(3 * u * t * t)).
+		Add(p3.Scale(t * t * t))
+}
+
+// Evaluates the derivative (tangent) of the cubic Bezier curve at `t`.
+func CubicBezierDeriv[P Point[P]](p0, p1, p2, p3 P, t float32) P {
+	u := 1 - t
+	return p1.Sub(p0).Scale(3 * u * u).
+		Add(p2.Sub(p1).Scale(6 * u * t)).
+		Add(p3.Sub(p2).Scale(3 * t * t))
+}
+
+// Evaluates the cubic Hermite spline at `t` in the `[0, 1]` range.
+//
+// The curve starts at `p0` with the tangent `m0`
+// and ends at `p1` with the tangent `m1`.
+func Hermite[P Point[P]](p0, m0, p1, m1 P, t float32) P {
+	t2 := t * t
+	t3 := t2 * t
+	return p0.Scale(2*t3 - 3*t2 + 1).
+		Add(m0.Scale(t3 - 2*t2 + t)).
+		Add(p1.Scale(-2*t3 + 3*t2)).
+		Add(m1.Scale(t3 - t2))
+}
+
+// Evaluates the derivative (tangent) of the cubic Hermite spline at `t`.
+func HermiteDeriv[P Point[P]](p0, m0, p1, m1 P, t float32) P {
+	t2 := t * t
+	return p0.Scale(6*t2 - 6*t).
+		Add(m0.Scale(3*t2 - 4*t + 1)).
+		Add(p1.Scale(-6*t2 + 6*t)).
+		Add(m1.Scale(3*t2 - 2*t))
+}
+
+// Evaluates the uniform Catmull-Rom spline segment between `p1` and `p2`
+// at `t` in the `[0, 1]` range.
+//
+// The points `p0` and `p3` are the neighbours used to calculate tangents.
+func CatmullRom[P Point[P]](p0, p1, p2, p3 P, t float32) P {
+	m1 := p2.Sub(p0).Scale(0.5)
+	m2 := p3.Sub(p1).Scale(0.5)
+	return Hermite(p1, m1, p2, m2, t)
+}
+
+// Evaluates the derivative (tangent) of the uniform Catmull-Rom spline segment.
+func CatmullRomDeriv[P Point[P]](p0, p1, p2, p3 P, t float32) P {
+	m1 := p2.Sub(p0).Scale(0.5)
+	m2 := p3.Sub(p1).Scale(0.5)
+	return HermiteDeriv(p1, m1, p2, m2, t)
+}
+
+// Approximates the length of the curve by summing up the lengths
+// of `segments` equal-step chords.
+//
+// The `curve` must accept `t` in the `[0, 1]` range.
+// The result is always less than or equal to the true length
+// (up to the precision of [Point.Len]).
+func ArcLength[P Point[P]](curve func(t float32) P, segments int) float32 {
+	if segments < 1 {
+		segments = 1
+	}
+	step := 1 / float32(segments)
+	prev := curve(0)
+	var length float32
+	for i := 1; i <= segments; i++ {
+		next := curve(float32(i) * step)
+		length += next.Sub(prev).Len()
+		prev = next
+	}
+	return length
+}
+
+// Flattens the quadratic Bezier curve into line segments using adaptive subdivision.
+//
+// The vertices are appended to `dst` which is then returned.
+// The start point `p0` is not appended, so that consecutive curves of a path
+// can be flattened into the same slice without duplicate vertices.
+// The distance between the curve and the polyline is at most `tolerance`
+// (up to float32 rounding) as long as [Point.Len] doesn't underestimate.
+// [Vec2] and [Vec3] satisfy that: the soft [tinymath.Sqrt] overestimates
+// by up to 6%, so it costs extra vertices but not precision.
+func FlattenQuad[P Point[P]](dst []P, p0, p1, p2 P, tolerance float32) []P {
+	return flattenQuad(dst, p0, p1, p2, tolerance, 0)
+}
+
+func flattenQuad[P Point[P]](dst []P, p0, p1, p2 P, tolerance float32, depth int) []P {
+	// The max distance from the chord is 1/4 of the second difference.
+	dd := p0.Sub(p1.Scale(2)).Add(p2).Len()
+	if depth >= maxDepth || dd*0.25 <= tolerance {
+		return append(dst, p2)
+	}
+	// de Casteljau subdivision at t=0.5
+	p01 := lerp(p0, p1, 0.5)
+	p12 := lerp(p1, p2, 0.5)
+	mid := lerp(p01, p12, 0.5)
+	dst = flattenQuad(dst, p0, p01, mid, tolerance, depth+1)
+	return flattenQuad(dst, mid, p12, p2, tolerance, depth+1)
+}
+
+// Flattens the cubic Bezier curve into line segments using adaptive subdivision.
+//
+// See [FlattenQuad] for the details.
+func FlattenCubic[P Point[P]](dst []P, p0, p1, p2, p3 P, tolerance float32) []P {
+	return flattenCubic(dst, p0, p1, p2, p3, tolerance, 0)
+}
+
+func flattenCubic[P Point[P]](dst []P, p0, p1, p2, p3 P, tolerance float32, depth int) []P {
+	// The max distance from the chord is 3/4 of the largest second difference.
+	dd1 := p0.Sub(p1.Scale(2)).Add(p2).Len()
+	dd2 := p1.Sub(p2.Scale(2)).Add(p3).Len()
+	if dd2 > dd1 {
+		dd1 = dd2
+	}
+	if depth >= maxDepth || dd1*0.75 <= tolerance {
+		return append(dst, p3)
+	}
+	// de Casteljau subdivision at t=0.5
+	p01 := lerp(p0, p1, 0.5)
+	p12 := lerp(p1, p2, 0.5)
+	p23 := lerp(p2, p3, 0.5)
+	p012 := lerp(p01, p12, 0.5)
+	p123 := lerp(p12, p23, 0.5)
+	mid := lerp(p012, p123, 0.5)
+	dst = flattenCubic(dst, p0, p01, p012, mid, tolerance, depth+1)
+	return flattenCubic(dst, mid, p123, p23, p3, tolerance, depth+1)
+}
@@ -0,0 +1,192 @@
+package curve_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/orsinium-labs/tinymath"
+	"github.com/orsinium-labs/tinymath/curve"
+)
+
+func close(t *testing.T, act, exp float32, eps float32) {
+	t.Helper()
+	if tinymath.Abs(act-exp) > eps {
+		t.Fatalf("%f != %f", act, exp)
+	}
+}
+
+func close2(t *testing.T, act, exp curve.Vec2, eps float32) {
+	t.Helper()
+	if tinymath.Abs(act.X-exp.X) > eps || tinymath.Abs(act.Y-exp.Y) > eps {
+		t.Fatalf("%v != %v", act, exp)
+	}
+}
+
+var (
+	b0 = curve.Vec2{0, 0}
+	b1 = curve.Vec2{1, 2}
+	b2 = curve.Vec2{3, 2}
+	b3 = curve.Vec2{4, 0}
+)
+
+func TestQuadBezier(t *testing.T) {
+	t.Parallel()
+	close2(t, curve.QuadBezier(b0, b1, b3, 0), b0, 0)
+	close2(t, curve.QuadBezier(b0, b1, b3, 1), b3, 0)
+	close2(t, curve.QuadBezier(b0, b1, b3, 0.5), curve.Vec2{1.5, 1}, 1e-6)
+	var s curve.Scalar = curve.QuadBezier[curve.Scalar](1, 3, 2, 0.5)
+	close(t, float32(s), 2.25, 1e-6)
+}
+
+func TestCubicBezier(t *testing.T) {
+	t.Parallel()
+	close2(t, curve.CubicBezier(b0, b1, b2, b3, 0), b0, 0)
+	close2(t, curve.CubicBezier(b0, b1, b2, b3, 1), b3, 0)
+	close2(t, curve.CubicBezier(b0, b1, b2, b3, 0.5), curve.Vec2{2, 1.5}, 1e-6)
+	p := curve.CubicBezier(
+		curve.Vec3{0, 0, 0}, curve.Vec3{0, 0, 1}, curve.Vec3{0, 0, 2}, curve.Vec3{0, 0, 3},
+		0.25,
+	)
+	close(t, p.Z, 0.75, 1e-6)
+}
+
+func TestDerivatives(t *testing.T) {
+	t.Parallel()
+	// Compare analytical derivatives with central finite differences.
+	const h = 1e-3
+	for i := 1; i < 10; i++ {
+		x := float32(i) / 10
+		t.Run(fmt.Sprintf("%f", x), func(t *testing.T) {
+			fd := curve.QuadBezier(b0, b1, b3, x+h).Sub(curve.QuadBezier(b0, b1, b3, x-h)).Scale(1 / (2 * h))
+			close2(t, curve.QuadBezierDeriv(b0, b1, b3, x), fd, 0.01)
+
+			fd = curve.CubicBezier(b0, b1, b2, b3, x+h).Sub(curve.CubicBezier(b0, b1, b2, b3, x-h)).Scale(1 / (2 * h))
+			close2(t, curve.CubicBezierDeriv(b0, b1, b2, b3, x), fd, 0.01)
+
+			fd = curve.Hermite(b0, b1, b2, b3, x+h).Sub(curve.Hermite(b0, b1, b2, b3, x-h)).Scale(1 / (2 * h))
+			close2(t, curve.HermiteDeriv(b0, b1, b2, b3, x), fd, 0.01)
+
+			fd = curve.CatmullRom(b0, b1, b2, b3, x+h).Sub(curve.CatmullRom(b0, b1, b2, b3, x-h)).Scale(1 / (2 * h))
+			close2(t, curve.CatmullRomDeriv(b0, b1, b2, b3, x), fd, 0.01)
+		})
+	}
+}
+
+func TestHermite(t *testing.T) {
+	t.Parallel()
+	m0 := curve.Vec2{1, 0}
+	m1 := curve.Vec2{0, -1}
+	close2(t, curve.Hermite(b1, m0, b2, m1, 0), b1, 0)
+	close2(t, curve.Hermite(b1, m0, b2, m1, 1), b2, 0)
+	close2(t, curve.HermiteDeriv(b1, m0, b2, m1, 0), m0, 1e-6)
+	close2(t, curve.HermiteDeriv(b1, m0, b2, m1, 1), m1, 1e-6)
+}
+
+func TestCatmullRom(t *testing.T) {
+	t.Parallel()
+	close2(t, curve.CatmullRom(b0, b1, b2, b3, 0), b1, 0)
+	close2(t, curve.CatmullRom(b0, b1, b2, b3, 1), b2, 0)
+	// The tangent at p1 is parallel to p2-p0.
+	close2(t, curve.CatmullRomDeriv(b0, b1, b2, b3, 0), b2.Sub(b0).Scale(0.5), 1e-6)
+
+	// Equidistant collinear points produce a uniform motion.
+	s := curve.CatmullRom[curve.Scalar](0, 1, 2, 3, 0.3)
+	close(t, float32(s), 1.3, 1e-6)
+}
+
+func TestArcLength(t *testing.T) {
+	t.Parallel()
+	line := func(t float32) curve.Scalar {
+		return curve.CubicBezier[curve.Scalar](1, 2, 3, 4, t)
+	}
+	close(t, curve.ArcLength(line, 8), 3, 1e-5)
+
+	// A quarter of the unit circle approximated by a cubic Bezier.
+	const k = 0.552_284_8
+	arc := func(t float32) curve.Vec2 {
+		return curve.CubicBezier(curve.Vec2{1, 0}, curve.Vec2{1, k}, curve.Vec2{k, 1}, curve.Vec2{0, 1}, t)
+	}
+	// Hypot uses the approximate Sqrt, hence the large tolerance.
+	close(t, curve.ArcLength(arc, 32), tinymath.FracPi2, 0.05*tinymath.FracPi2)
+}
+
+// The flattening bound relies on Len never being shorter than the true length.
+func TestLenNotUnderestimated(t *testing.T) {
+	t.Parallel()
+	for x := float32(-4); x <= 4; x += 0.0625 {
+		for y := float32(-4); y <= 4; y += 0.0625 {
+			exp := math.Hypot(float64(x), float64(y))
+			if act := float64(curve.Vec2{x, y}.Len()); act < exp*(1-1e-7) {
+				t.Fatalf("Vec2{%f, %f}: %f < %f", x, y, act, exp)
+			}
+			exp = math.Sqrt(float64(x*x + y*y + 1))
+			if act := float64(curve.Vec3{x, y, 1}.Len()); act < exp*(1-1e-7) {
+				t.Fatalf("Vec3{%f, %f, 1}: %f < %f", x, y, act, exp)
+			}
+		}
+	}
+}
+
+// The distance from the point to the segment, calculated with float64.
+func distToSegment(p, a, b curve.Vec2) float64 {
+	px, py := float64(p.X-a.X), float64(p.Y-a.Y)
+	dx, dy := float64(b.X-a.X), float64(b.Y-a.Y)
+	l2 := dx*dx + dy*dy
+	u := 0.0
+	if l2 > 0 {
+		u = math.Max(0, math.Min(1, (px*dx+py*dy)/l2))
+	}
+	return math.Hypot(px-u*dx, py-u*dy)
+}
+
+// The distance from the point to the closest segment of the polyline.
+func distToPolyline(p curve.Vec2, poly []curve.Vec2) float64 {
+	best := math.Inf(1)
+	for i := 1; i < len(poly); i++ {
+		best = math.Min(best, distToSegment(p, poly[i-1], poly[i]))
+	}
+	return best
+}
+
+func TestFlattenCubic(t *testing.T) {
+	t.Parallel()
+	for _, tol := range []float32{1, 0.1, 0.01, 0.001} {
+		tol := tol
+		t.Run(fmt.Sprintf("%f", tol), func(t *testing.T) {
+			poly := curve.FlattenCubic([]curve.Vec2{b0}, b0, b1, b2, b3, tol)
+			if poly[len(poly)-1] != b3 {
+				t.Fatalf("the last vertex is %v", poly[len(poly)-1])
+			}
+			for i := 0; i <= 100; i++ {
+				p := curve.CubicBezier(b0, b1, b2, b3, float32(i)/100)
+				d := distToPolyline(p, poly)
+				if d > float64(tol)*1.0001 {
+					t.Fatalf("%d: %f > %f", len(poly), d, tol)
+				}
+			}
+		})
+	}
+}
+
+func TestFlattenQuad(t *testing.T) {
+	t.Parallel()
+	coarse := curve.FlattenQuad(nil, b0, b1, b3, 1)
+	fine := curve.FlattenQuad(nil, b0, b1, b3, 0.001)
+	if len(coarse) >= len(fine) {
+		t.Fatalf("%d >= %d", len(coarse), len(fine))
+	}
+	poly := append([]curve.Vec2{b0}, fine...)
+	for i := 0; i <= 100; i++ {
+		p := curve.QuadBezier(b0, b1, b3, float32(i)/100)
+		if d := distToPolyline(p, poly); d > 0.001*1.0001 {
+			t.Fatalf("%f", d)
+		}
+	}
+
+	// A straight line is flat already.
+	line := curve.FlattenQuad(nil, b0, curve.Vec2{2, 0}, b3, 0.001)
+	if len(line) != 1 {
+		t.Fatalf("%d", len(line))
+	}
+}
@@ -0,0 +1,89 @@
+// Package curve evaluates Bezier, Hermite and Catmull-Rom splines.
+//
+// All functions are generic over [Point] so that the same code works
+// for [Scalar], [Vec2], and [Vec3] (or any user-defined point type).
+package curve
+
+import "github.com/orsinium-labs/tinymath"
+
+// A value that can be used as a control point of a curve.
+type Point[P any] interface {
+	Add(P) P
+	Sub(P) P
+	Scale(float32) P
+
+	// The distance from the origin.
+	Len() float32
+}
+
+// A point on the number line.
+type Scalar float32
+
+var _ Point[Scalar] = Scalar(0)
+
+func (p Scalar) Add(q Scalar) Scalar {
+	return p + q
+}
+
+func (p Scalar) Sub(q Scalar) Scalar {
+	return p - q
+}
+
+func (p Scalar) Scale(k float32) Scalar {
+	return Scalar(float32(p) * k)
+}
+
+func (p Scalar) Len() float32 {
+	return tinymath.Abs(float32(p))
+}
+
+// A point on a plane.
+type Vec2 struct {
+	X, Y float32
+}
+
+var _ Point[Vec2] = Vec2{}
+
+func (p Vec2) Add(q Vec2) Vec2 {
+	return Vec2{p.X + q.X, p.Y + q.Y}
+}
+
+func (p Vec2) Sub(q Vec2) Vec2 {
+	return Vec2{p.X - q.X, p.Y - q.Y}
+}
+
+func (p Vec2) Scale(k float32) Vec2 {
+	return Vec2{p.X * k, p.Y * k}
+}
+
+func (p Vec2) Len() float32 {
+	return tinymath.Hypot(p.X, p.Y)
+}
+
+// A point in space.
+type Vec3 struct {
+	X, Y, Z float32
+}
+
+var _ Point[Vec3] = Vec3{}
+
+func (p Vec3) Add(q Vec3) Vec3 {
+	return Vec3{p.X + q.X, p.Y + q.Y, p.Z + q.Z}
+}
+
+func (p Vec3) Sub(q Vec3) Vec3 {
+	return Vec3{p.X - q.X, p.Y - q.Y, p.Z - q.Z}
+}
+
+func (p Vec3) Scale(k float32) Vec3 {
+	return Vec3{p.X * k, p.Y * k, p.Z * k}
+}
+
+func (p Vec3) Len() float32 {
+	return tinymath.Sqrt(p.X*p.X + p.Y*p.Y + p.Z*p.Z)
+}
+
+// Linearly interpolates between two points.
+func lerp[P Point[P]](a, b P, t float32) P {
+	return a.Add(b.Sub(a).Scale(t))
+}
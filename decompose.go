@@ -0,0 +1,157 @@
+package tinymath
+
+// Functions for decomposing a float into its parts and for building it back.
+// Unlike the rest of the library, they are exact.
+
+// Breaks the number into a normalized fraction and an integral power of two.
+//
+// Returns `frac` and `exp` such that `self == frac × 2^exp`,
+// with the absolute value of `frac` in the `[0.5, 1)` range.
+//
+// Special cases are:
+//
+//   - `Frexp(±0) = ±0, 0`
+//   - `Frexp(±Inf) = ±Inf, 0`
+//   - `Frexp(NaN) = NaN, 0`
+func Frexp(self float32) (frac float32, exp int32) {
+	if self == 0 || IsNaN(self) || Abs(self) == Inf {
+		return self, 0
+	}
+	self, exp = normalize(self)
+	exp += extractExponentValue(self) + 1
+	bits := ToBits(self)&^expMask | (expBias-1)<<mantissaBits
+	return FromBits(bits), exp
+}
+
+// The inverse of [Frexp], returns `frac × 2^exp`.
+//
+// The result is correctly rounded when it's subnormal
+// and is an infinity when it overflows.
+func Ldexp(frac float32, exp int32) float32 {
+	// Ported from musl's scalbnf.
+	// The scaling is split into steps so that each multiplier is a normal number.
+	// Then the final multiplication is the only one that can round.
+	y := frac
+	if exp > 127 {
+		y *= 0x1p127
+		exp -= 127
+		if exp > 127 {
+			y *= 0x1p127
+			exp -= 127
+			if exp > 127 {
+				exp = 127
+			}
+		}
+	} else if exp < -126 {
+		// Scale down by less than the max to not round twice.
+		y *= 0x1p-126 * 0x1p24
+		exp += 126 - 24
+		if exp < -126 {
+			y *= 0x1p-126 * 0x1p24
+			exp += 126 - 24
+			if exp < -126 {
+				exp = -126
+			}
+		}
+	}
+	return y * FromBits(uint32(expBias+exp)<<mantissaBits)
+}
+
+// Splits the number into the integer part and the fractional part.
+//
+// Both parts have the same sign as `self`.
+// The integer part is the same as [Trunc] and the fractional part as [Fract].
+//
+// Special cases are:
+//
+//   - `Modf(±Inf) = ±Inf, NaN`
+//   - `Modf(NaN) = NaN, NaN`
+func Modf(self float32) (integer float32, frac float32) {
+	if IsNaN(self) || Abs(self) == Inf {
+		return self, NaN
+	}
+	return Trunc(self), Fract(self)
+}
+
+// Returns the binary exponent of the number as an integer.
+//
+// For normal numbers, it's the same as `Floor(Log2(Abs(self)))` but exact.
+//
+// Special cases are:
+//
+//   - `Ilogb(±Inf) = MaxInt32`
+//   - `Ilogb(0) = MinInt32`
+//   - `Ilogb(NaN) = MaxInt32`
+func Ilogb(self float32) int32 {
+	if self == 0 {
+		return -1 << 31
+	}
+	if IsNaN(self) || Abs(self) == Inf {
+		return 1<<31 - 1
+	}
+	self, exp := normalize(self)
+	return exp + extractExponentValue(self)
+}
+
+// Returns the binary exponent of the number as a float.
+//
+// Special cases are:
+//
+//   - `Logb(±Inf) = +Inf`
+//   - `Logb(0) = -Inf`
+//   - `Logb(NaN) = NaN`
+func Logb(self float32) float32 {
+	if self == 0 {
+		return NegInf
+	}
+	if Abs(self) == Inf {
+		return Inf
+	}
+	if IsNaN(self) {
+		return self
+	}
+	return float32(Ilogb(self))
+}
+
+// Returns the next representable float after `self` in the direction of `to`.
+//
+// Special cases are:
+//
+//   - `Nextafter(x, x) = x`
+//   - `Nextafter(NaN, y) = NaN`
+//   - `Nextafter(x, NaN) = NaN`
+func Nextafter(self float32, to float32) float32 {
+	switch {
+	case IsNaN(self) || IsNaN(to):
+		return NaN
+	case self == to:
+		return self
+	case self == 0:
+		return CopySign(FromBits(1), to)
+	case (to > self) == (self > 0):
+		return FromBits(ToBits(self) + 1)
+	default:
+		return FromBits(ToBits(self) - 1)
+	}
+}
+
+// Returns the smallest float greater than `self`.
+func Nextup(self float32) float32 {
+	return Nextafter(self, Inf)
+}
+
+// Returns the largest float less than `self`.
+func Nextdown(self float32) float32 {
+	return Nextafter(self, NegInf)
+}
+
+// Scales a subnormal number up to be normal.
+//
+// Returns the scaled number and the power of two to multiply it by
+// to get the original number.
+func normalize(self float32) (float32, int32) {
+	if ToBits(self)&expMask == 0 {
+		return self * 0x1p25, -25
+	}
+	return self, 0
+}
@@ -0,0 +1,113 @@
+package tinymath_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/orsinium-labs/tinymath"
+)
+
+// Values covering zeros, subnormals, normals, and special values.
+var decomposeSamples = []float32{
+	0, float32(math.Copysign(0, -1)),
+	tinymath.FromBits(1), tinymath.FromBits(0x0000_1234), tinymath.FromBits(0x007f_ffff),
+	-tinymath.FromBits(1), -tinymath.FromBits(0x007f_ffff),
+	tinymath.FromBits(0x0080_0000), -tinymath.FromBits(0x0080_0000),
+	1e-30, 0.1, 0.5, 0.75, 1, 1.5, 2, 3, 100.25, 12345.678, 1e20, math.MaxFloat32,
+	-0.1, -1, -3, -1e20, -math.MaxFloat32,
+	tinymath.Inf, tinymath.NegInf, tinymath.NaN,
+}
+
+// Checks that the floats are identical, including the sign of zero and NaN.
+func same(t *testing.T, act, exp float32) {
+	t.Helper()
+	if tinymath.IsNaN(act) && tinymath.IsNaN(exp) {
+		return
+	}
+	if tinymath.ToBits(act) != tinymath.ToBits(exp) {
+		t.Fatalf("%g (%#x) != %g (%#x)", act, tinymath.ToBits(act), exp, tinymath.ToBits(exp))
+	}
+}
+
+func TestFrexp(t *testing.T) {
+	t.Parallel()
+	for _, x := range decomposeSamples {
+		x := x
+		t.Run(fmt.Sprintf("%g", x), func(t *testing.T) {
+			frac, exp := tinymath.Frexp(x)
+			efrac, eexp := math.Frexp(float64(x))
+			same(t, frac, float32(efrac))
+			if int(exp) != eexp {
+				t.Fatalf("exp: %d != %d", exp, eexp)
+			}
+			same(t, tinymath.Ldexp(frac, exp), x)
+		})
+	}
+}
+
+func TestLdexp(t *testing.T) {
+	t.Parallel()
+	exps := []int32{-300, -277, -160, -150, -149, -140, -127, -126, -10, -1, 0, 1, 10, 127, 128, 200, 300}
+	for _, x := range decomposeSamples {
+		for _, e := range exps {
+			x := x
+			e := e
+			t.Run(fmt.Sprintf("%g_%d", x, e), func(t *testing.T) {
+				exp := float32(math.Ldexp(float64(x), int(e)))
+				same(t, tinymath.Ldexp(x, e), exp)
+			})
+		}
+	}
+}
+
+func TestModf(t *testing.T) {
+	t.Parallel()
+	for _, x := range decomposeSamples {
+		x := x
+		t.Run(fmt.Sprintf("%g", x), func(t *testing.T) {
+			i, f := tinymath.Modf(x)
+			ei, ef := math.Modf(float64(x))
+			same(t, i, float32(ei))
+			same(t, f, float32(ef))
+		})
+	}
+}
+
+func TestIlogb(t *testing.T) {
+	t.Parallel()
+	for _, x := range decomposeSamples {
+		x := x
+		t.Run(fmt.Sprintf("%g", x), func(t *testing.T) {
+			exp := math.Ilogb(float64(x))
+			if int(tinymath.Ilogb(x)) != exp {
+				t.Fatalf("%d != %d", tinymath.Ilogb(x), exp)
+			}
+			same(t, tinymath.Logb(x), float32(math.Logb(float64(x))))
+		})
+	}
+	same(t, tinymath.Logb(0), tinymath.NegInf)
+	same(t, tinymath.Logb(tinymath.NegInf), tinymath.Inf)
+	same(t, tinymath.Logb(tinymath.NaN), tinymath.NaN)
+}
+
+func TestNextafter(t *testing.T) {
+	t.Parallel()
+	targets := []float32{tinymath.NegInf, -1, 0, 1, tinymath.Inf, tinymath.NaN}
+	for _, x := range decomposeSamples {
+		for _, y := range targets {
+			x := x
+			y := y
+			t.Run(fmt.Sprintf("%g_%g", x, y), func(t *testing.T) {
+				same(t, tinymath.Nextafter(x, y), math.Nextafter32(x, y))
+			})
+		}
+		x := x
+		t.Run(fmt.Sprintf("up_%g", x), func(t *testing.T) {
+			same(t, tinymath.Nextup(x), math.Nextafter32(x, tinymath.Inf))
+			same(t, tinymath.Nextdown(x), math.Nextafter32(x, tinymath.NegInf))
+		})
+	}
+	same(t, tinymath.Nextup(1), 1+tinymath.Epsilon)
+	same(t, tinymath.Nextup(0), tinymath.FromBits(1))
+}
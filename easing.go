@@ -0,0 +1,242 @@
+package tinymath
+
+// Easing functions by Robert Penner.
+//
+// Each function accepts the progress `t` in the `[0, 1]` range and returns
+// the eased progress which is `0` at `t=0` and `1` at `t=1`.
+// Back and elastic easings overshoot the `[0, 1]` range in between.
+//
+// https://easings.net/
+
+const (
+	easeBackC1    = 1.70158
+	easeBackC2    = easeBackC1 * 1.525
+	easeBackC3    = easeBackC1 + 1
+	easeElasticC4 = Tau / 3
+	easeElasticC5 = Tau / 4.5
+	easeBounceN1  = 7.5625
+	easeBounceD1  = 2.75
+)
+
+// Quadratic ease-in.
+func EaseInQuad(t float32) float32 {
+	return t * t
+}
+
+// Quadratic ease-out.
+func EaseOutQuad(t float32) float32 {
+	return 1 - (1-t)*(1-t)
+}
+
+// Quadratic ease-in-out.
+func EaseInOutQuad(t float32) float32 {
+	if t < 0.5 {
+		return 2 * t * t
+	}
+	u := -2*t + 2
+	return 1 - u*u/2
+}
+
+// Cubic ease-in.
+func EaseInCubic(t float32) float32 {
+	return t * t * t
+}
+
+// Cubic ease-out.
+func EaseOutCubic(t float32) float32 {
+	u := 1 - t
+	return 1 - u*u*u
+}
+
+// Cubic ease-in-out.
+func EaseInOutCubic(t float32) float32 {
+	if t < 0.5 {
+		return 4 * t * t * t
+	}
+	u := -2*t + 2
+	return 1 - u*u*u/2
+}
+
+// Quartic ease-in.
+func EaseInQuart(t float32) float32 {
+	return t * t * t * t
+}
+
+// Quartic ease-out.
+func EaseOutQuart(t float32) float32 {
+	u := 1 - t
+	return 1 - u*u*u*u
+}
+
+// Quartic ease-in-out.
+func EaseInOutQuart(t float32) float32 {
+	if t < 0.5 {
+		return 8 * t * t * t * t
+	}
+	u := -2*t + 2
+	return 1 - u*u*u*u/2
+}
+
+// Quintic ease-in.
+func EaseInQuint(t float32) float32 {
+	return t * t * t * t * t
+}
+
+// Quintic ease-out.
+func EaseOutQuint(t float32) float32 {
+	u := 1 - t
+	return 1 - u*u*u*u*u
+}
+
+// Quintic ease-in-out.
+func EaseInOutQuint(t float32) float32 {
+	if t < 0.5 {
+		return 16 * t * t * t * t * t
+	}
+	u := -2*t + 2
+	return 1 - u*u*u*u*u/2
+}
+
+// Sinusoidal ease-in.
+func EaseInSine(t float32) float32 {
+	return 1 - Cos(t*FracPi2)
+}
+
+// Sinusoidal ease-out.
+func EaseOutSine(t float32) float32 {
+	return Sin(t * FracPi2)
+}
+
+// Sinusoidal ease-in-out.
+func EaseInOutSine(t float32) float32 {
+	return -(Cos(Pi*t) - 1) / 2
+}
+
+// Exponential ease-in.
+func EaseInExpo(t float32) float32 {
+	if t == 0 {
+		return 0
+	}
+	return PowF(2, 10*t-10)
+}
+
+// Exponential ease-out.
+func EaseOutExpo(t float32) float32 {
+	if t == 1 {
+		return 1
+	}
+	return 1 - PowF(2, -10*t)
+}
+
+// Exponential ease-in-out.
+func EaseInOutExpo(t float32) float32 {
+	if t == 0 {
+		return 0
+	}
+	if t == 1 {
+		return 1
+	}
+	if t < 0.5 {
+		return PowF(2, 20*t-10) / 2
+	}
+	return (2 - PowF(2, -20*t+10)) / 2
+}
+
+// Circular ease-in.
+func EaseInCirc(t float32) float32 {
+	return 1 - Sqrt(1-t*t)
+}
+
+// Circular ease-out.
+func EaseOutCirc(t float32) float32 {
+	u := t - 1
+	return Sqrt(1 - u*u)
+}
+
+// Circular ease-in-out.
+func EaseInOutCirc(t float32) float32 {
+	if t < 0.5 {
+		u := 2 * t
+		return (1 - Sqrt(1-u*u)) / 2
+	}
+	u := -2*t + 2
+	return (Sqrt(1-u*u) + 1) / 2
+}
+
+// Ease-in that slightly backs off before moving forward.
+func EaseInBack(t float32) float32 {
+	return easeBackC3*t*t*t - easeBackC1*t*t
+}
+
+// Ease-out that slightly overshoots the target before settling.
+func EaseOutBack(t float32) float32 {
+	u := t - 1
+	return 1 + easeBackC3*u*u*u + easeBackC1*u*u
+}
+
+// Ease-in-out that backs off at the start and overshoots at the end.
+func EaseInOutBack(t float32) float32 {
+	if t < 0.5 {
+		u := 2 * t
+		return u * u * ((easeBackC2+1)*u - easeBackC2) / 2
+	}
+	u := 2*t - 2
+	return (u*u*((easeBackC2+1)*u+easeBackC2) + 2) / 2
+}
+
+// Ease-in that oscillates like a spring with growing amplitude.
+func EaseInElastic(t float32) float32 {
+	if t == 0 || t == 1 {
+		return t
+	}
+	return -PowF(2, 10*t-10) * Sin((t*10-10.75)*easeElasticC4)
+}
+
+// Ease-out that oscillates like a spring with decaying amplitude.
+func EaseOutElastic(t float32) float32 {
+	if t == 0 || t == 1 {
+		return t
+	}
+	return PowF(2, -10*t)*Sin((t*10-0.75)*easeElasticC4) + 1
+}
+
+// Ease-in-out that oscillates like a spring on both ends.
+func EaseInOutElastic(t float32) float32 {
+	if t == 0 || t == 1 {
+		return t
+	}
+	if t < 0.5 {
+		return -(PowF(2, 20*t-10) * Sin((20*t-11.125)*easeElasticC5)) / 2
+	}
+	return PowF(2, -20*t+10)*Sin((20*t-11.125)*easeElasticC5)/2 + 1
+}
+
+// Ease-in that bounces off the start like a dropped ball played backwards.
+func EaseInBounce(t float32) float32 {
+	return 1 - EaseOutBounce(1-t)
+}
+
+// Ease-out that bounces at the target like a dropped ball.
+func EaseOutBounce(t float32) float32 {
+	if t < 1/easeBounceD1 {
+		return easeBounceN1 * t * t
+	}
+	if t < 2/easeBounceD1 {
+		t -= 1.5 / easeBounceD1
+		return easeBounceN1*t*t + 0.75
+	}
+	if t < 2.5/easeBounceD1 {
+		t -= 2.25 / easeBounceD1
+		return easeBounceN1*t*t + 0.9375
+	}
+	t -= 2.625 / easeBounceD1
+	return easeBounceN1*t*t + 0.984375
+}
+
+// Ease-in-out that bounces on both ends.
+func EaseInOutBounce(t float32) float32 {
+	if t < 0.5 {
+		return (1 - EaseOutBounce(1-2*t)) / 2
+	}
+	return (1 + EaseOutBounce(2*t-1)) / 2
+}
@@ -0,0 +1,85 @@
+package tinymath_test
+
+import (
+	"testing"
+
+	"github.com/orsinium-labs/tinymath"
+)
+
+func TestEasing(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name string
+		f    func(float32) float32
+		mid  float32
+		eps  float32
+	}{
+		{"InQuad", tinymath.EaseInQuad, 0.25, 0.005},
+		{"OutQuad", tinymath.EaseOutQuad, 0.75, 0.005},
+		{"InOutQuad", tinymath.EaseInOutQuad, 0.5, 0.005},
+		{"InCubic", tinymath.EaseInCubic, 0.125, 0.005},
+		{"OutCubic", tinymath.EaseOutCubic, 0.875, 0.005},
+		{"InOutCubic", tinymath.EaseInOutCubic, 0.5, 0.005},
+		{"InQuart", tinymath.EaseInQuart, 0.0625, 0.005},
+		{"OutQuart", tinymath.EaseOutQuart, 0.9375, 0.005},
+		{"InOutQuart", tinymath.EaseInOutQuart, 0.5, 0.005},
+		{"InQuint", tinymath.EaseInQuint, 0.03125, 0.005},
+		{"OutQuint", tinymath.EaseOutQuint, 0.96875, 0.005},
+		{"InOutQuint", tinymath.EaseInOutQuint, 0.5, 0.005},
+		{"InSine", tinymath.EaseInSine, 0.292_893_2, 0.005},
+		{"OutSine", tinymath.EaseOutSine, 0.707_106_8, 0.005},
+		{"InOutSine", tinymath.EaseInOutSine, 0.5, 0.005},
+		{"InExpo", tinymath.EaseInExpo, 0.03125, 0.005},
+		{"OutExpo", tinymath.EaseOutExpo, 0.96875, 0.005},
+		{"InOutExpo", tinymath.EaseInOutExpo, 0.5, 0.005},
+		{"InCirc", tinymath.EaseInCirc, 0.133_974_6, 0.02},
+		{"OutCirc", tinymath.EaseOutCirc, 0.866_025_4, 0.02},
+		{"InOutCirc", tinymath.EaseInOutCirc, 0.5, 0.02},
+		{"InBack", tinymath.EaseInBack, -0.087_697_5, 0.005},
+		{"OutBack", tinymath.EaseOutBack, 1.087_697_5, 0.005},
+		{"InOutBack", tinymath.EaseInOutBack, 0.5, 0.005},
+		{"InElastic", tinymath.EaseInElastic, -0.015625, 0.005},
+		{"OutElastic", tinymath.EaseOutElastic, 1.015625, 0.005},
+		{"InOutElastic", tinymath.EaseInOutElastic, 0.5, 0.005},
+		{"InBounce", tinymath.EaseInBounce, 0.234375, 0.005},
+		{"OutBounce", tinymath.EaseOutBounce, 0.765625, 0.005},
+		{"InOutBounce", tinymath.EaseInOutBounce, 0.5, 0.005},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			close(t, c.f(0), 0, 0.001)
+			close(t, c.f(0.5), c.mid, c.eps)
+			close(t, c.f(1), 1, 0.001)
+		})
+	}
+}
+
+func TestEasingSymmetry(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name string
+		in   func(float32) float32
+		out  func(float32) float32
+	}{
+		{"Quad", tinymath.EaseInQuad, tinymath.EaseOutQuad},
+		{"Cubic", tinymath.EaseInCubic, tinymath.EaseOutCubic},
+		{"Quart", tinymath.EaseInQuart, tinymath.EaseOutQuart},
+		{"Quint", tinymath.EaseInQuint, tinymath.EaseOutQuint},
+		{"Sine", tinymath.EaseInSine, tinymath.EaseOutSine},
+		{"Expo", tinymath.EaseInExpo, tinymath.EaseOutExpo},
+		{"Circ", tinymath.EaseInCirc, tinymath.EaseOutCirc},
+		{"Back", tinymath.EaseInBack, tinymath.EaseOutBack},
+		{"Elastic", tinymath.EaseInElastic, tinymath.EaseOutElastic},
+		{"Bounce", tinymath.EaseInBounce, tinymath.EaseOutBounce},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			// Ease-out is the ease-in mirrored along both axes.
+			for x := float32(0.05); x < 1; x += 0.1 {
+				close(t, c.out(x), 1-c.in(1-x), 0.02)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package tinymath
+
+// Software fallbacks exported for tests, so that they can be checked
+// on every architecture, including the ones where hardware is used instead.
+var (
+	CeilSoft         = ceilSoft
+	FloorSoft        = floorSoft
+	SqrtSoft         = sqrtSoft
+	TruncSoft        = truncSoft
+	FMASoft          = fmaSoft
+	LeadingZerosSoft = leadingZerosSoft
+)
+
+// Both kinds of slice kernels exported for tests, so that they can be
+// compared with each other no matter which one the build uses.
+var (
+	SinLanes       = sinLanes
+	SinScalar      = sinScalar
+	CosLanes       = cosLanes
+	CosScalar      = cosScalar
+	ExpLanes       = expLanes
+	ExpScalar      = expScalar
+	SqrtLanes      = sqrtLanes
+	SqrtScalar     = sqrtScalar
+	FloorLanes     = floorLanes
+	FloorScalar    = floorScalar
+	ClampLanes     = clampLanes
+	ClampScalar    = clampScalar
+	ScaleAddLanes  = scaleAddLanes
+	ScaleAddScalar = scaleAddScalar
+)
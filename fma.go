@@ -0,0 +1,33 @@
+package tinymath
+
+import "unsafe"
+
+// Computes `x*y + z` with a single rounding using float64 arithmetic.
+//
+// The product of two float32 values fits into float64 exactly,
+// so only the sum needs care. The float64 sum is rounded to odd:
+// if it's inexact, the last bit is forced to be 1. That way,
+// the second rounding into float32 can't fall onto a false tie.
+//
+// https://www.lri.fr/~melquion/doc/08-tc.pdf
+func fmaSoft(x, y, z float32) float32 {
+	p := float64(x) * float64(y)
+	zz := float64(z)
+	s := p + zz
+
+	// The error of the sum (TwoSum by Knuth).
+	pp := s - zz
+	e := (p - pp) + (zz - (s - pp))
+
+	bits := *(*uint64)(unsafe.Pointer(&s))
+	if e != 0 && bits&1 == 0 && s == s && s-s == 0 {
+		// Move towards the exact result by one ulp.
+		if (e > 0) == (s > 0) {
+			bits++
+		} else {
+			bits--
+		}
+		s = *(*float64)(unsafe.Pointer(&bits))
+	}
+	return float32(s)
+}
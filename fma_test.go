@@ -0,0 +1,65 @@
+package tinymath_test
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/orsinium-labs/tinymath"
+)
+
+// Computes `x*y + z` exactly and rounds the result to float32.
+func fmaRef(x, y, z float32) float32 {
+	bx := new(big.Float).SetPrec(300).SetFloat64(float64(x))
+	by := new(big.Float).SetPrec(300).SetFloat64(float64(y))
+	bz := new(big.Float).SetPrec(300).SetFloat64(float64(z))
+	r := new(big.Float).SetPrec(300).Mul(bx, by)
+	r.Add(r, bz)
+	f, _ := r.Float32()
+	return f
+}
+
+func TestFMA(t *testing.T) {
+	t.Parallel()
+	cases := [][4]float32{
+		{2, 3, 4, 10},
+		{0, 0, 0, 0},
+		{1, 1, -1, 0},
+		{tinymath.Inf, 1, 1, tinymath.Inf},
+		{tinymath.Inf, 0, 1, tinymath.NaN},
+		{tinymath.NaN, 1, 1, tinymath.NaN},
+		{1e30, 1e30, 0, tinymath.Inf},
+		{1e30, 1e30, tinymath.NegInf, tinymath.NegInf},
+		// Rounding the float64 sum into float32 would produce a false tie here.
+		{1 + 0x1p-12, 1 + 0x1p-12, 0x1p-70, 1 + 0x1p-11 + 0x1p-23},
+		{1 + 0x1p-12, 1 + 0x1p-12, -0x1p-70, 1 + 0x1p-11},
+		// The rounding error of the product.
+		{1 + 0x1p-12, 1 + 0x1p-12, -(1 + 0x1p-11), 0x1p-24},
+		// Subnormal results.
+		{0x1p-100, 0x1p-40, 0x1p-149, 0x1p-140 + 0x1p-149},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("%g_%g_%g", c[0], c[1], c[2]), func(t *testing.T) {
+			same(t, tinymath.FMA(c[0], c[1], c[2]), c[3])
+		})
+	}
+}
+
+func TestFMARandom(t *testing.T) {
+	t.Parallel()
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100_000; i++ {
+		x := float32(rng.NormFloat64())
+		y := float32(rng.NormFloat64())
+		// Make z close to -x*y to get catastrophic cancellations.
+		z := -x*y + float32(rng.NormFloat64())*float32(math.Pow(2, float64(rng.Intn(60)-50)))
+		act := tinymath.FMA(x, y, z)
+		exp := fmaRef(x, y, z)
+		if act != exp {
+			t.Fatalf("FMA(%g, %g, %g): %g != %g", x, y, z, act, exp)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package tinymath
+
+import "unsafe"
+
+// Returns the largest and the smallest value of an integer type.
+func intBounds[N int32 | uint32 | int64]() (N, N) {
+	var zero N
+	bits := unsafe.Sizeof(zero) * 8
+	if ^zero > 0 {
+		// unsigned
+		return ^zero, 0
+	}
+	max := N(^uint64(0) >> (65 - bits))
+	return max, -max - 1
+}
+
+// Computes `a + b`, saturating at the numeric bounds instead of overflowing.
+func SaturatingAdd[N int32 | uint32 | int64](a, b N) N {
+	max, min := intBounds[N]()
+	c := a + b
+	if min == 0 {
+		if c < a {
+			return max
+		}
+		return c
+	}
+	if b > 0 && c < a {
+		return max
+	}
+	if b < 0 && c > a {
+		return min
+	}
+	return c
+}
+
+// Computes `a - b`, saturating at the numeric bounds instead of overflowing.
+func SaturatingSub[N int32 | uint32 | int64](a, b N) N {
+	max, min := intBounds[N]()
+	c := a - b
+	if min == 0 {
+		if b > a {
+			return 0
+		}
+		return c
+	}
+	if b > 0 && c > a {
+		return min
+	}
+	if b < 0 && c < a {
+		return max
+	}
+	return c
+}
+
+// Computes `a * b`, saturating at the numeric bounds instead of overflowing.
+func SaturatingMul[N int32 | uint32 | int64](a, b N) N {
+	c, ok := checkedMul(a, b)
+	if ok {
+		return c
+	}
+	max, min := intBounds[N]()
+	if (a < 0) != (b < 0) {
+		return min
+	}
+	return max
+}
+
+// Computes `a * b` and reports if it hasn't overflowed.
+func checkedMul[N int32 | uint32 | int64](a, b N) (N, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	_, min := intBounds[N]()
+	c := a * b
+	// Division of the min value by -1 overflows and returns the min value.
+	minusOne := ^N(0)
+	if min != 0 && ((a == minusOne && b == min) || (b == minusOne && a == min)) {
+		return c, false
+	}
+	return c, c/a == b
+}
+
+// Computes `base` raised to the power of `exp`.
+//
+// The second return value is false if the result has overflowed.
+func IPow[N int32 | uint32 | int64](base N, exp uint32) (N, bool) {
+	var result N = 1
+	ok := true
+	for {
+		if exp&1 == 1 {
+			var o bool
+			result, o = checkedMul(result, base)
+			ok = ok && o
+		}
+		exp >>= 1
+		if exp == 0 {
+			return result, ok
+		}
+		var o bool
+		base, o = checkedMul(base, base)
+		ok = ok && o
+	}
+}
+
+// Computes the integer square root of a number, the largest `r` such that `r*r <= x`.
+//
+// Returns `0` for negative numbers.
+func ISqrt[N int32 | uint32 | int64](x N) N {
+	if x <= 0 {
+		return 0
+	}
+	// Digit-by-digit calculation in base 2.
+	n := uint64(x)
+	var r uint64
+	bit := uint64(1) << (uint32(ILog2(x)) &^ 1)
+	for bit != 0 {
+		if n >= r+bit {
+			n -= r + bit
+			r = r>>1 + bit
+		} else {
+			r >>= 1
+		}
+		bit >>= 2
+	}
+	return N(r)
+}
+
+// Computes the floor of the base 2 logarithm of a number,
+// which is the index of the most significant set bit.
+//
+// Returns `-1` for zero and negative numbers.
+func ILog2[N int32 | uint32 | int64](x N) int32 {
+	if x <= 0 {
+		return -1
+	}
+	n := uint64(x)
+	if hi := uint32(n >> 32); hi != 0 {
+		return 63 - int32(leadingZeros(hi))
+	}
+	return 31 - int32(leadingZeros(uint32(n)))
+}
+
+// Computes the ceiling of the base 2 logarithm of a number,
+// which is the exponent of the smallest power of two that is greater than or equal to `x`.
+//
+// Returns `-1` for zero and negative numbers.
+func CeilLog2[N int32 | uint32 | int64](x N) int32 {
+	if x <= 0 {
+		return -1
+	}
+	l := ILog2(x)
+	if x&(x-1) != 0 {
+		l++
+	}
+	return l
+}
+
+// Computes the greatest common divisor of two numbers.
+//
+// The result is always non-negative, except when it's the min value of the type
+// (for example, `GCD[int32](math.MinInt32, 0)`) which can't be negated.
+// Returns `0` if both numbers are zero.
+func GCD[N int32 | uint32 | int64](a, b N) N {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+// Computes the least common multiple of two numbers.
+//
+// The result is always non-negative. Returns `0` if either number is zero.
+// The second return value is false if the result has overflowed.
+func LCM[N int32 | uint32 | int64](a, b N) (N, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	l, ok := checkedMul(a/GCD(a, b), b)
+	if l < 0 {
+		_, min := intBounds[N]()
+		if l == min {
+			return l, false
+		}
+		l = -l
+	}
+	return l, ok
+}
@@ -0,0 +1,250 @@
+package tinymath_test
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/orsinium-labs/tinymath"
+)
+
+var int32Samples = []int32{
+	math.MinInt32, math.MinInt32 + 1, -65536, -46341, -1000, -7, -2, -1,
+	0, 1, 2, 3, 7, 12, 1000, 46340, 46341, 65536, math.MaxInt32 - 1, math.MaxInt32,
+}
+
+var int64Samples = []int64{
+	math.MinInt64, math.MinInt64 + 1, -3037000500, -1 << 32, -1000, -2, -1,
+	0, 1, 2, 3, 1000, 1 << 32, 3037000499, 3037000500, math.MaxInt64 - 1, math.MaxInt64,
+}
+
+var uint32Samples = []uint32{
+	0, 1, 2, 3, 7, 1000, 65535, 65536, 1 << 31, math.MaxUint32 - 1, math.MaxUint32,
+}
+
+// Clamps the arbitrary precision result into the range of the type.
+func saturate(r *big.Int, min, max int64) int64 {
+	if r.Cmp(big.NewInt(min)) < 0 {
+		return min
+	}
+	if r.Cmp(big.NewInt(max)) > 0 {
+		return max
+	}
+	return r.Int64()
+}
+
+func TestSaturatingInt32(t *testing.T) {
+	t.Parallel()
+	for _, a := range int32Samples {
+		for _, b := range int32Samples {
+			ba, bb := big.NewInt(int64(a)), big.NewInt(int64(b))
+			add := saturate(new(big.Int).Add(ba, bb), math.MinInt32, math.MaxInt32)
+			sub := saturate(new(big.Int).Sub(ba, bb), math.MinInt32, math.MaxInt32)
+			mul := saturate(new(big.Int).Mul(ba, bb), math.MinInt32, math.MaxInt32)
+			if act := tinymath.SaturatingAdd(a, b); int64(act) != add {
+				t.Fatalf("%d + %d: %d != %d", a, b, act, add)
+			}
+			if act := tinymath.SaturatingSub(a, b); int64(act) != sub {
+				t.Fatalf("%d - %d: %d != %d", a, b, act, sub)
+			}
+			if act := tinymath.SaturatingMul(a, b); int64(act) != mul {
+				t.Fatalf("%d * %d: %d != %d", a, b, act, mul)
+			}
+		}
+	}
+}
+
+func TestSaturatingInt64(t *testing.T) {
+	t.Parallel()
+	for _, a := range int64Samples {
+		for _, b := range int64Samples {
+			ba, bb := big.NewInt(a), big.NewInt(b)
+			add := saturate(new(big.Int).Add(ba, bb), math.MinInt64, math.MaxInt64)
+			sub := saturate(new(big.Int).Sub(ba, bb), math.MinInt64, math.MaxInt64)
+			mul := saturate(new(big.Int).Mul(ba, bb), math.MinInt64, math.MaxInt64)
+			if act := tinymath.SaturatingAdd(a, b); act != add {
+				t.Fatalf("%d + %d: %d != %d", a, b, act, add)
+			}
+			if act := tinymath.SaturatingSub(a, b); act != sub {
+				t.Fatalf("%d - %d: %d != %d", a, b, act, sub)
+			}
+			if act := tinymath.SaturatingMul(a, b); act != mul {
+				t.Fatalf("%d * %d: %d != %d", a, b, act, mul)
+			}
+		}
+	}
+}
+
+func TestSaturatingUint32(t *testing.T) {
+	t.Parallel()
+	for _, a := range uint32Samples {
+		for _, b := range uint32Samples {
+			ba, bb := big.NewInt(int64(a)), big.NewInt(int64(b))
+			add := saturate(new(big.Int).Add(ba, bb), 0, math.MaxUint32)
+			sub := saturate(new(big.Int).Sub(ba, bb), 0, math.MaxUint32)
+			mul := saturate(new(big.Int).Mul(ba, bb), 0, math.MaxUint32)
+			if act := tinymath.SaturatingAdd(a, b); int64(act) != add {
+				t.Fatalf("%d + %d: %d != %d", a, b, act, add)
+			}
+			if act := tinymath.SaturatingSub(a, b); int64(act) != sub {
+				t.Fatalf("%d - %d: %d != %d", a, b, act, sub)
+			}
+			if act := tinymath.SaturatingMul(a, b); int64(act) != mul {
+				t.Fatalf("%d * %d: %d != %d", a, b, act, mul)
+			}
+		}
+	}
+}
+
+func TestIPow(t *testing.T) {
+	t.Parallel()
+	for _, base := range []int64{-10, -3, -2, -1, 0, 1, 2, 3, 7, 10, 46341} {
+		for exp := uint32(0); exp < 70; exp++ {
+			exp := exp
+			base := base
+			t.Run(fmt.Sprintf("%d_%d", base, exp), func(t *testing.T) {
+				exp64 := new(big.Int).Exp(big.NewInt(base), big.NewInt(int64(exp)), nil)
+
+				act64, ok := tinymath.IPow(base, exp)
+				if ok != exp64.IsInt64() {
+					t.Fatalf("int64 overflow: %v", ok)
+				}
+				if ok && act64 != exp64.Int64() {
+					t.Fatalf("int64: %d != %d", act64, exp64)
+				}
+
+				act32, ok := tinymath.IPow(int32(base), exp)
+				fits32 := exp64.IsInt64() && exp64.Int64() >= math.MinInt32 && exp64.Int64() <= math.MaxInt32
+				if ok != fits32 {
+					t.Fatalf("int32 overflow: %v", ok)
+				}
+				if ok && int64(act32) != exp64.Int64() {
+					t.Fatalf("int32: %d != %d", act32, exp64)
+				}
+			})
+		}
+	}
+	if r, ok := tinymath.IPow[uint32](2, 31); !ok || r != 1<<31 {
+		t.Fatalf("uint32: %d %v", r, ok)
+	}
+	if _, ok := tinymath.IPow[uint32](2, 32); ok {
+		t.Fatal("uint32 overflow")
+	}
+}
+
+func TestISqrt(t *testing.T) {
+	t.Parallel()
+	for _, x := range int64Samples {
+		r := tinymath.ISqrt(x)
+		if x <= 0 {
+			if r != 0 {
+				t.Fatalf("isqrt(%d) = %d", x, r)
+			}
+			continue
+		}
+		exp := new(big.Int).Sqrt(big.NewInt(x)).Int64()
+		if r != exp {
+			t.Fatalf("isqrt(%d): %d != %d", x, r, exp)
+		}
+	}
+	for x := int32(0); x < 10000; x++ {
+		r := tinymath.ISqrt(x)
+		if r*r > x || (r+1)*(r+1) <= x {
+			t.Fatalf("isqrt(%d) = %d", x, r)
+		}
+	}
+	for _, x := range uint32Samples {
+		r := uint64(tinymath.ISqrt(x))
+		if r*r > uint64(x) || (r+1)*(r+1) <= uint64(x) {
+			t.Fatalf("isqrt(%d) = %d", x, r)
+		}
+	}
+}
+
+func TestILog2(t *testing.T) {
+	t.Parallel()
+	for _, x := range int64Samples {
+		if x <= 0 {
+			if tinymath.ILog2(x) != -1 || tinymath.CeilLog2(x) != -1 {
+				t.Fatalf("log2(%d)", x)
+			}
+			continue
+		}
+		floor := int32(big.NewInt(x).BitLen() - 1)
+		ceil := int32(big.NewInt(x - 1).BitLen())
+		if act := tinymath.ILog2(x); act != floor {
+			t.Fatalf("ilog2(%d): %d != %d", x, act, floor)
+		}
+		if act := tinymath.CeilLog2(x); act != ceil {
+			t.Fatalf("ceillog2(%d): %d != %d", x, act, ceil)
+		}
+	}
+	for _, x := range uint32Samples[1:] {
+		floor := int32(big.NewInt(int64(x)).BitLen() - 1)
+		if act := tinymath.ILog2(x); act != floor {
+			t.Fatalf("ilog2(%d): %d != %d", x, act, floor)
+		}
+	}
+	if tinymath.ILog2[int32](1024) != 10 || tinymath.CeilLog2[int32](1025) != 11 {
+		t.Fatal("powers of two")
+	}
+}
+
+func TestGCD(t *testing.T) {
+	t.Parallel()
+	cases := [][3]int32{
+		{0, 0, 0},
+		{0, 5, 5},
+		{5, 0, 5},
+		{12, 18, 6},
+		{-12, 18, 6},
+		{12, -18, 6},
+		{-12, -18, 6},
+		{17, 5, 1},
+		{1 << 20, 1 << 12, 1 << 12},
+	}
+	for _, c := range cases {
+		if act := tinymath.GCD(c[0], c[1]); act != c[2] {
+			t.Fatalf("gcd(%d, %d): %d != %d", c[0], c[1], act, c[2])
+		}
+	}
+	if tinymath.GCD[uint32](math.MaxUint32, 3) != 3 {
+		t.Fatal("uint32")
+	}
+}
+
+func TestLCM(t *testing.T) {
+	t.Parallel()
+	cases := [][3]int32{
+		{0, 5, 0},
+		{4, 6, 12},
+		{-4, 6, 12},
+		{-4, -6, 12},
+		{7, 13, 91},
+	}
+	for _, c := range cases {
+		act, ok := tinymath.LCM(c[0], c[1])
+		if !ok || act != c[2] {
+			t.Fatalf("lcm(%d, %d): %d != %d", c[0], c[1], act, c[2])
+		}
+	}
+	if _, ok := tinymath.LCM[int32](65536, 65537); ok {
+		t.Fatal("overflow")
+	}
+	if _, ok := tinymath.LCM[int32](math.MinInt32, 2); ok {
+		t.Fatal("min value overflow")
+	}
+	if act, ok := tinymath.LCM[int64](65536, 65537); !ok || act != 65536*65537 {
+		t.Fatalf("int64: %d", act)
+	}
+}
+
+func TestExpSaturation(t *testing.T) {
+	t.Parallel()
+	// The exponent of huge negative numbers underflows to zero.
+	eq(t, tinymath.Exp(-1e20), 0)
+	// And of huge positive numbers overflows to infinity.
+	eq(t, tinymath.Exp(1e10), tinymath.Inf)
+	eq(t, tinymath.Exp(1e20), tinymath.Inf)
+}
@@ -0,0 +1,49 @@
+package tinymath
+
+// Restricts `self` to the `[lo, hi]` range.
+func Clamp[N float32 | int32](self, lo, hi N) N {
+	if self < lo {
+		return lo
+	}
+	if self > hi {
+		return hi
+	}
+	return self
+}
+
+// Linearly interpolates between `a` and `b`.
+//
+// Returns `a` if `t` is `0` and `b` if `t` is `1`.
+// Values of `t` outside of `[0, 1]` extrapolate.
+func Lerp(a, b, t float32) float32 {
+	return a + (b-a)*t
+}
+
+// Finds `t` for which `Lerp(a, b, t)` is `self`. The inverse of [Lerp].
+//
+// Returns [NaN] or infinity if `a` is equal to `b`.
+func InverseLerp(a, b, self float32) float32 {
+	return (self - a) / (b - a)
+}
+
+// Maps `self` from the `[in_min, in_max]` range into the `[out_min, out_max]` range.
+func Remap(self, in_min, in_max, out_min, out_max float32) float32 {
+	return Lerp(out_min, out_max, InverseLerp(in_min, in_max, self))
+}
+
+// Performs smooth Hermite interpolation between `0` and `1`
+// when `edge0 < self < edge1`.
+//
+// Returns `0` if `self <= edge0` and `1` if `self >= edge1`.
+func Smoothstep(edge0, edge1, self float32) float32 {
+	t := Clamp((self-edge0)/(edge1-edge0), 0, 1)
+	return t * t * (3 - 2*t)
+}
+
+// Like [Smoothstep] but also has zero second-order derivatives at the edges.
+//
+// Proposed by Ken Perlin.
+func Smootherstep(edge0, edge1, self float32) float32 {
+	t := Clamp((self-edge0)/(edge1-edge0), 0, 1)
+	return t * t * t * (t*(t*6-15) + 10)
+}
@@ -0,0 +1,112 @@
+package tinymath_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/orsinium-labs/tinymath"
+)
+
+func TestClamp(t *testing.T) {
+	t.Parallel()
+	eq(t, tinymath.Clamp[float32](-1, 0, 1), 0)
+	eq(t, tinymath.Clamp[float32](0.5, 0, 1), 0.5)
+	eq(t, tinymath.Clamp[float32](2, 0, 1), 1)
+	if tinymath.Clamp[int32](-7, -5, 5) != -5 {
+		t.Fatal("int32 lower bound")
+	}
+	if tinymath.Clamp[int32](7, -5, 5) != 5 {
+		t.Fatal("int32 upper bound")
+	}
+}
+
+func TestLerp(t *testing.T) {
+	t.Parallel()
+	cases := []Case{
+		{0.0, 2.0},
+		{1.0, 10.0},
+		{0.5, 6.0},
+		{0.25, 4.0},
+		{-1.0, -6.0},
+		{2.0, 18.0},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("%f", c.Given), func(t *testing.T) {
+			eq(t, tinymath.Lerp(2, 10, c.Given), c.Expected)
+		})
+	}
+}
+
+func TestInverseLerp(t *testing.T) {
+	t.Parallel()
+	cases := []Case{
+		{2.0, 0.0},
+		{10.0, 1.0},
+		{6.0, 0.5},
+		{4.0, 0.25},
+		{-6.0, -1.0},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("%f", c.Given), func(t *testing.T) {
+			eq(t, tinymath.InverseLerp(2, 10, c.Given), c.Expected)
+			eq(t, tinymath.Lerp(2, 10, c.Expected), c.Given)
+		})
+	}
+}
+
+func TestRemap(t *testing.T) {
+	t.Parallel()
+	cases := []Case{
+		{0.0, -1.0},
+		{1023.0, 1.0},
+		{511.5, 0.0},
+		{255.75, -0.5},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("%f", c.Given), func(t *testing.T) {
+			close(t, tinymath.Remap(c.Given, 0, 1023, -1, 1), c.Expected, tinymath.Epsilon)
+		})
+	}
+}
+
+func TestSmoothstep(t *testing.T) {
+	t.Parallel()
+	cases := []Case{
+		{-1.0, 0.0},
+		{0.0, 0.0},
+		{0.25, 0.15625},
+		{0.5, 0.5},
+		{0.75, 0.84375},
+		{1.0, 1.0},
+		{2.0, 1.0},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("%f", c.Given), func(t *testing.T) {
+			eq(t, tinymath.Smoothstep(0, 1, c.Given), c.Expected)
+			eq(t, tinymath.Smoothstep(10, 20, 10+c.Given*10), c.Expected)
+		})
+	}
+}
+
+func TestSmootherstep(t *testing.T) {
+	t.Parallel()
+	cases := []Case{
+		{-1.0, 0.0},
+		{0.0, 0.0},
+		{0.25, 0.103515625},
+		{0.5, 0.5},
+		{0.75, 0.896484375},
+		{1.0, 1.0},
+		{2.0, 1.0},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("%f", c.Given), func(t *testing.T) {
+			eq(t, tinymath.Smootherstep(0, 1, c.Given), c.Expected)
+		})
+	}
+}
@@ -0,0 +1,219 @@
+// Package kalman provides small Kalman filters that don't allocate.
+//
+// All covariance updates use the Joseph form which, unlike the textbook
+// `P = (I - KH)P`, keeps the covariance symmetric and positive-definite
+// in single precision.
+package kalman
+
+// A Kalman filter for a single variable that follows a random walk,
+// like a slowly changing temperature or a battery voltage.
+type Filter1D struct {
+	// The state estimate.
+	X float32
+	// The variance of the state estimate.
+	P float32
+	// The process noise variance, added on every [Filter1D.Predict].
+	Q float32
+	// The measurement noise variance.
+	R float32
+}
+
+// Create a new [Filter1D] starting at `x` with the variance `p`.
+func New1D(x, p, q, r float32) Filter1D {
+	return Filter1D{X: x, P: p, Q: q, R: r}
+}
+
+// Advance the filter by one step.
+func (f *Filter1D) Predict() {
+	f.P += f.Q
+}
+
+// Correct the state estimate with the measurement `z`.
+func (f *Filter1D) Update(z float32) {
+	k := f.P / (f.P + f.R)
+	f.X += k * (z - f.X)
+	// Joseph form: (1-k)P(1-k) + kRk
+	f.P = (1-k)*(1-k)*f.P + k*k*f.R
+}
+
+// A Kalman filter for a position and a velocity of an object moving with
+// a constant velocity (or a known acceleration) along one axis.
+//
+// The position is measured directly. The acceleration, if known
+// (for instance, from an accelerometer), is used as the control input.
+// That's the classic setup for fusing a barometer and an accelerometer
+// into the altitude and vertical speed, or for smoothing a GPS track
+// (one filter per axis).
+type ConstVel struct {
+	// The position estimate.
+	X float32
+	// The velocity estimate.
+	V float32
+	// The covariance of the estimate:
+	// P[0][0] is the position variance, P[1][1] is the velocity variance.
+	P [2][2]float32
+	// The variance of the unknown acceleration (the process noise).
+	Q float32
+	// The position measurement noise variance.
+	R float32
+}
+
+// Create a new [ConstVel] filter at the position `x` with zero velocity.
+//
+// The initial variance of both position and velocity is `p`.
+func NewConstVel(x, p, q, r float32) ConstVel {
+	return ConstVel{X: x, P: [2][2]float32{{p, 0}, {0, p}}, Q: q, R: r}
+}
+
+// Advance the filter by `dt` with the known acceleration `a`.
+//
+// Pass zero as `a` if the acceleration is unknown.
+func (f *ConstVel) Predict(dt, a float32) {
+	f.X += f.V*dt + 0.5*a*dt*dt
+	f.V += a * dt
+
+	// P = F P F^T + Q, where F = [[1, dt], [0, 1]].
+	p := &f.P
+	p00 := p[0][0] + dt*(p[1][0]+p[0][1]) + dt*dt*p[1][1]
+	p01 := p[0][1] + dt*p[1][1]
+	p11 := p[1][1]
+
+	// The process noise of the white noise acceleration model:
+	// Q = q * [[dt^4/4, dt^3/2], [dt^3/2, dt^2]].
+	dt2 := dt * dt
+	p00 += f.Q * dt2 * dt2 / 4
+	p01 += f.Q * dt2 * dt / 2
+	p11 += f.Q * dt2
+
+	p[0][0] = p00
+	p[0][1] = p01
+	p[1][0] = p01
+	p[1][1] = p11
+}
+
+// Correct the state estimate with the measured position `z`.
+func (f *ConstVel) Update(z float32) {
+	p := &f.P
+	s := p[0][0] + f.R
+	k0 := p[0][0] / s
+	k1 := p[1][0] / s
+	y := z - f.X
+	f.X += k0 * y
+	f.V += k1 * y
+
+	// Joseph form: (I-KH) P (I-KH)^T + K R K^T, where H = [1, 0].
+	a00 := 1 - k0
+	a10 := -k1
+	p00 := a00*a00*p[0][0] + k0*k0*f.R
+	p01 := a00*(a10*p[0][0]+p[0][1]) + k0*k1*f.R
+	p11 := a10*a10*p[0][0] + 2*a10*p[0][1] + p[1][1] + k1*k1*f.R
+
+	p[0][0] = p00
+	p[0][1] = p01
+	p[1][0] = p01
+	p[1][1] = p11
+}
+
+// A generic linear Kalman filter with up to [MaxN] state variables and measurements.
+//
+// All matrices are exported and can be adjusted between steps
+// (for example, to update F for a variable dt).
+type Filter struct {
+	// The number of state variables.
+	N int
+	// The number of measurements.
+	M int
+
+	// The state estimate (N).
+	X Vector
+	// The covariance of the state estimate (N x N).
+	P Matrix
+	// The state transition model (N x N).
+	F Matrix
+	// The process noise covariance (N x N).
+	Q Matrix
+	// The observation model that maps the state into measurements (M x N).
+	H Matrix
+	// The measurement noise covariance (M x M).
+	R Matrix
+}
+
+// Create a new [Filter] with `n` state variables and `m` measurements.
+//
+// F and P are initialized as identity matrices, everything else is zero.
+// Panics if `n` or `m` is out of the `[1, MaxN]` range.
+func New(n, m int) Filter {
+	if n < 1 || n > MaxN || m < 1 || m > MaxN {
+		panic("kalman: the filter size is out of range")
+	}
+	return Filter{N: n, M: m, F: Identity(n), P: Identity(n)}
+}
+
+// Advance the filter by one step.
+func (f *Filter) Predict() {
+	n := f.N
+	f.X = mulVec(&f.F, &f.X, n, n)
+	fp := mul(&f.F, &f.P, n, n, n)
+	f.P = mulT(&fp, &f.F, n, n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			f.P[i][j] += f.Q[i][j]
+		}
+	}
+	symmetrize(&f.P, n)
+}
+
+// Correct the state estimate with the measurements `z` (M).
+//
+// Returns false and leaves the state unchanged if the innovation covariance
+// is singular, which usually means that both P and R are zero.
+func (f *Filter) Update(z Vector) bool {
+	n, m := f.N, f.M
+
+	// The innovation and its covariance: y = z - Hx, S = HPH^T + R.
+	hx := mulVec(&f.H, &f.X, m, n)
+	var y Vector
+	for i := 0; i < m; i++ {
+		y[i] = z[i] - hx[i]
+	}
+	hp := mul(&f.H, &f.P, m, n, n)
+	s := mulT(&hp, &f.H, m, n, m)
+	for i := 0; i < m; i++ {
+		for j := 0; j < m; j++ {
+			s[i][j] += f.R[i][j]
+		}
+	}
+	si, ok := invert(s, m)
+	if !ok {
+		return false
+	}
+
+	// The Kalman gain: K = PH^T S^-1.
+	pht := mulT(&f.P, &f.H, n, n, m)
+	k := mul(&pht, &si, n, m, m)
+
+	ky := mulVec(&k, &y, n, m)
+	for i := 0; i < n; i++ {
+		f.X[i] += ky[i]
+	}
+
+	// Joseph form: P = (I-KH) P (I-KH)^T + K R K^T.
+	a := mul(&k, &f.H, n, m, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			a[i][j] = -a[i][j]
+		}
+		a[i][i] += 1
+	}
+	ap := mul(&a, &f.P, n, n, n)
+	f.P = mulT(&ap, &a, n, n, n)
+	kr := mul(&k, &f.R, n, m, m)
+	krk := mulT(&kr, &k, n, m, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			f.P[i][j] += krk[i][j]
+		}
+	}
+	symmetrize(&f.P, n)
+	return true
+}
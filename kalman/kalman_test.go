@@ -0,0 +1,193 @@
+package kalman_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/orsinium-labs/tinymath"
+	"github.com/orsinium-labs/tinymath/kalman"
+)
+
+func close(t *testing.T, act, exp float32, eps float32) {
+	t.Helper()
+	if tinymath.Abs(act-exp) > eps {
+		t.Fatalf("%f != %f", act, exp)
+	}
+}
+
+func TestFilter1D(t *testing.T) {
+	t.Parallel()
+	rng := rand.New(rand.NewSource(1))
+	f := kalman.New1D(0, 100, 1e-3, 4)
+	for i := 0; i < 1000; i++ {
+		f.Predict()
+		f.Update(20 + float32(rng.NormFloat64()*2))
+	}
+	close(t, f.X, 20, 0.5)
+
+	// The steady-state variance solves P = (P+Q)R/(P+Q+R).
+	q, r := float64(f.Q), float64(f.R)
+	pp := (-q + math.Sqrt(q*q+4*q*r)) / 2
+	p := pp * r / (pp + r)
+	close(t, f.P, float32(p), float32(p)*0.05)
+}
+
+func TestFilter1DSingleUpdate(t *testing.T) {
+	t.Parallel()
+	f := kalman.New1D(0, 1, 0, 1)
+	f.Update(2)
+	// Equal confidence in the prior and the measurement.
+	close(t, f.X, 1, 1e-6)
+	close(t, f.P, 0.5, 1e-6)
+}
+
+func TestConstVel(t *testing.T) {
+	t.Parallel()
+	rng := rand.New(rand.NewSource(2))
+	const dt = 0.1
+	f := kalman.NewConstVel(0, 100, 0.01, 1)
+	var pos float32 = 5
+	for i := 0; i < 1000; i++ {
+		pos += 3 * dt
+		f.Predict(dt, 0)
+		f.Update(pos + float32(rng.NormFloat64()))
+	}
+	close(t, f.X, pos, 0.5)
+	close(t, f.V, 3, 0.2)
+	if f.P[0][1] != f.P[1][0] {
+		t.Fatal("asymmetric covariance")
+	}
+}
+
+func TestConstVelAcceleration(t *testing.T) {
+	t.Parallel()
+	rng := rand.New(rand.NewSource(3))
+	const dt = 0.01
+	// Altitude from a noisy barometer and a noiseless accelerometer.
+	f := kalman.NewConstVel(0, 1, 0.1, 0.25)
+	var alt, vel float32
+	for i := 0; i < 2000; i++ {
+		a := float32(math.Sin(float64(i) * dt))
+		alt += vel*dt + 0.5*a*dt*dt
+		vel += a * dt
+		f.Predict(dt, a)
+		f.Update(alt + float32(rng.NormFloat64()*0.5))
+	}
+	close(t, f.X, alt, 0.2)
+	close(t, f.V, vel, 0.2)
+}
+
+func TestGenericMatchesConstVel(t *testing.T) {
+	t.Parallel()
+	const dt = 0.1
+	const q = 0.01
+	cv := kalman.NewConstVel(0, 10, q, 1)
+
+	g := kalman.New(2, 1)
+	g.P = kalman.Matrix{{10, 0}, {0, 10}}
+	g.F[0][1] = dt
+	g.Q[0][0] = q * dt * dt * dt * dt / 4
+	g.Q[0][1] = q * dt * dt * dt / 2
+	g.Q[1][0] = q * dt * dt * dt / 2
+	g.Q[1][1] = q * dt * dt
+	g.H[0][0] = 1
+	g.R[0][0] = 1
+
+	rng := rand.New(rand.NewSource(4))
+	for i := 0; i < 200; i++ {
+		z := float32(i)*0.2 + float32(rng.NormFloat64())
+		cv.Predict(dt, 0)
+		cv.Update(z)
+		g.Predict()
+		if !g.Update(kalman.Vector{z}) {
+			t.Fatal("singular")
+		}
+		close(t, g.X[0], cv.X, 1e-3)
+		close(t, g.X[1], cv.V, 1e-3)
+		close(t, g.P[0][0], cv.P[0][0], 1e-4)
+		close(t, g.P[0][1], cv.P[0][1], 1e-4)
+		close(t, g.P[1][1], cv.P[1][1], 1e-4)
+	}
+}
+
+func TestGenericGPS(t *testing.T) {
+	t.Parallel()
+	// 2D position and velocity with position-only measurements.
+	const dt = 1
+	f := kalman.New(4, 2)
+	f.F[0][2] = dt
+	f.F[1][3] = dt
+	for i := 0; i < 4; i++ {
+		f.P[i][i] = 1000
+	}
+	f.Q[2][2] = 1e-4
+	f.Q[3][3] = 1e-4
+	f.H[0][0] = 1
+	f.H[1][1] = 1
+	f.R[0][0] = 25
+	f.R[1][1] = 25
+
+	rng := rand.New(rand.NewSource(5))
+	var x, y float32 = 100, -50
+	for i := 0; i < 300; i++ {
+		x += 2
+		y -= 1
+		f.Predict()
+		z := kalman.Vector{x + float32(rng.NormFloat64()*5), y + float32(rng.NormFloat64()*5)}
+		f.Update(z)
+	}
+	close(t, f.X[0], x, 3)
+	close(t, f.X[1], y, 3)
+	close(t, f.X[2], 2, 0.2)
+	close(t, f.X[3], -1, 0.2)
+}
+
+func TestGenericStability(t *testing.T) {
+	t.Parallel()
+	// Very precise measurements make the textbook covariance update
+	// lose positive-definiteness in float32. The Joseph form must not.
+	f := kalman.New(3, 1)
+	f.F[0][1] = 0.01
+	f.F[1][2] = 0.01
+	f.Q[2][2] = 1e-6
+	f.H[0][0] = 1
+	f.R[0][0] = 1e-8
+	for i := 0; i < 100_000; i++ {
+		f.Predict()
+		f.Update(kalman.Vector{float32(i % 7)})
+	}
+	for i := 0; i < 3; i++ {
+		if !(f.P[i][i] >= 0) {
+			t.Fatalf("P[%d][%d] = %f", i, i, f.P[i][i])
+		}
+		for j := 0; j < 3; j++ {
+			if f.P[i][j] != f.P[j][i] {
+				t.Fatalf("asymmetric at %d,%d", i, j)
+			}
+		}
+	}
+}
+
+func TestGenericSingular(t *testing.T) {
+	t.Parallel()
+	f := kalman.New(1, 1)
+	f.P[0][0] = 0
+	f.H[0][0] = 1
+	if f.Update(kalman.Vector{1}) {
+		t.Fatal("expected singular")
+	}
+	if f.X[0] != 0 {
+		t.Fatal("state changed")
+	}
+}
+
+func TestNewPanics(t *testing.T) {
+	t.Parallel()
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic")
+		}
+	}()
+	kalman.New(kalman.MaxN+1, 1)
+}
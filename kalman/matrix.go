@@ -0,0 +1,122 @@
+package kalman
+
+import "github.com/orsinium-labs/tinymath"
+
+// The maximum number of state variables and measurements of [Filter].
+//
+// Matrices are fixed-size arrays, so the filter never allocates
+// but the memory is always reserved for the maximum size.
+const MaxN = 6
+
+// A vector of up to [MaxN] elements.
+type Vector [MaxN]float32
+
+// A matrix of up to [MaxN]x[MaxN] elements in row-major order.
+type Matrix [MaxN][MaxN]float32
+
+// The identity matrix of the given size.
+func Identity(n int) Matrix {
+	var m Matrix
+	for i := 0; i < n; i++ {
+		m[i][i] = 1
+	}
+	return m
+}
+
+// Calculates `a*b` where `a` is `n` x `k` and `b` is `k` x `m`.
+func mul(a, b *Matrix, n, k, m int) Matrix {
+	var r Matrix
+	for i := 0; i < n; i++ {
+		for j := 0; j < m; j++ {
+			var s float32
+			for l := 0; l < k; l++ {
+				s += a[i][l] * b[l][j]
+			}
+			r[i][j] = s
+		}
+	}
+	return r
+}
+
+// Calculates `a*b^T` where `a` is `n` x `k` and `b` is `m` x `k`.
+func mulT(a, b *Matrix, n, k, m int) Matrix {
+	var r Matrix
+	for i := 0; i < n; i++ {
+		for j := 0; j < m; j++ {
+			var s float32
+			for l := 0; l < k; l++ {
+				s += a[i][l] * b[j][l]
+			}
+			r[i][j] = s
+		}
+	}
+	return r
+}
+
+// Calculates `a*v` where `a` is `n` x `k`.
+func mulVec(a *Matrix, v *Vector, n, k int) Vector {
+	var r Vector
+	for i := 0; i < n; i++ {
+		var s float32
+		for l := 0; l < k; l++ {
+			s += a[i][l] * v[l]
+		}
+		r[i] = s
+	}
+	return r
+}
+
+// Forces the `n` x `n` matrix to be exactly symmetric.
+//
+// Rounding errors make covariance matrices slightly asymmetric
+// and that asymmetry grows over time in single precision.
+func symmetrize(a *Matrix, n int) {
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			s := (a[i][j] + a[j][i]) / 2
+			a[i][j] = s
+			a[j][i] = s
+		}
+	}
+}
+
+// Inverts the `n` x `n` matrix using Gauss-Jordan elimination with partial pivoting.
+//
+// Returns false if the matrix is singular.
+func invert(a Matrix, n int) (Matrix, bool) {
+	inv := Identity(n)
+	for c := 0; c < n; c++ {
+		// Find the pivot.
+		p := c
+		for r := c + 1; r < n; r++ {
+			if tinymath.Abs(a[r][c]) > tinymath.Abs(a[p][c]) {
+				p = r
+			}
+		}
+		if a[p][c] == 0 {
+			return inv, false
+		}
+		a[c], a[p] = a[p], a[c]
+		inv[c], inv[p] = inv[p], inv[c]
+
+		// Normalize the pivot row.
+		k := 1 / a[c][c]
+		for j := 0; j < n; j++ {
+			a[c][j] *= k
+			inv[c][j] *= k
+		}
+
+		// Eliminate the column from all other rows.
+		for r := 0; r < n; r++ {
+			if r == c {
+				continue
+			}
+			f := a[r][c]
+			for j := 0; j < n; j++ {
+				a[r][j] -= f * a[c][j]
+				inv[r][j] -= f * inv[c][j]
+			}
+		}
+	}
+	return inv, true
+}
@@ -0,0 +1,145 @@
+// Package lut provides trigonometric functions based on a lookup table
+// with linear interpolation.
+//
+// They are more precise than [tinymath.Sin] and [tinymath.Cos]
+// at the cost of memory for the table. The table size is chosen by the user:
+//
+//	| size | memory  | max error |
+//	| ---- | -------:| ---------:|
+//	|   16 |    68 B |   1.9e-02 |
+//	|   64 |   260 B |   1.3e-03 |
+//	|  256 |  1028 B |   7.6e-05 |
+//	| 1024 |  4100 B |   4.8e-06 |
+//	| 4096 | 16388 B |   5.2e-07 |
+//
+// The error is measured for angles in `[-π, π]`. For bigger angles,
+// the precision of float32 itself becomes the limiting factor.
+//
+// The table can be either filled at runtime by [New] into a caller-provided array
+// or generated as Go source code by cmd/lutgen and loaded with [Load].
+package lut
+
+import "github.com/orsinium-labs/tinymath"
+
+// A table of sine values for one full turn.
+type Table struct {
+	values []float32
+	// The number of intervals in the table, as a float.
+	size float32
+}
+
+// Fill the buffer with sine values and create a [Table] from it.
+//
+// The length of the buffer must be a power of two (at least 4) plus one,
+// like 65 or 257. The extra element repeats the first one,
+// so that interpolation doesn't need to wrap around.
+// Panics if the length is invalid.
+func New(buf []float32) Table {
+	n := checkSize(len(buf))
+	quarter := n / 4
+	for i := 0; i <= quarter; i++ {
+		s := float32(sinTaylor(float64(i) * (2 * pi / float64(n))))
+		buf[i] = s
+		buf[n/2-i] = s
+		buf[n/2+i] = -s
+		buf[n-i] = -s
+	}
+	// Exact zeros, the symmetry above would produce -0.
+	buf[0] = 0
+	buf[n/2] = 0
+	buf[n] = 0
+	return Table{values: buf, size: float32(n)}
+}
+
+// Create a [Table] from values generated by cmd/lutgen (or by [New] earlier).
+//
+// The values aren't copied or modified.
+// Panics if the length is invalid, see [New].
+func Load(values []float32) Table {
+	n := checkSize(len(values))
+	return Table{values: values, size: float32(n)}
+}
+
+// Returns the number of intervals in the table, one less than its length.
+func (t Table) Size() int {
+	return len(t.values) - 1
+}
+
+// Approximates `sin(x)` in radians.
+func (t Table) Sin(x float32) float32 {
+	return t.lookup(x * (1 / tinymath.Tau))
+}
+
+// Approximates `cos(x)` in radians.
+func (t Table) Cos(x float32) float32 {
+	return t.lookup(x*(1/tinymath.Tau) + 0.25)
+}
+
+// Simultaneously computes the sine and cosine of the number, `x`.
+// Returns `(sin(x), cos(x))`.
+func (t Table) SinCos(x float32) (float32, float32) {
+	turns := x * (1 / tinymath.Tau)
+	return t.lookup(turns), t.lookup(turns + 0.25)
+}
+
+// Approximates `tan(x)` in radians.
+//
+// The relative error grows near the poles, where the cosine is close to zero.
+func (t Table) Tan(x float32) float32 {
+	s, c := t.SinCos(x)
+	return s / c
+}
+
+// Approximates `sin(2π·turns)`, the sine of an angle given in turns (full rotations).
+//
+// It skips the conversion from radians and so is a bit faster and more precise.
+func (t Table) SinTurns(turns float32) float32 {
+	return t.lookup(turns)
+}
+
+// Approximates `cos(2π·turns)`, the cosine of an angle given in turns (full rotations).
+func (t Table) CosTurns(turns float32) float32 {
+	return t.lookup(turns + 0.25)
+}
+
+func (t Table) lookup(turns float32) float32 {
+	turns -= tinymath.Floor(turns)
+	// The negated condition also catches NaN and infinities.
+	if !(turns >= 0 && turns <= 1) {
+		return tinymath.NaN
+	}
+	f := turns * t.size
+	i := int(f)
+	// Rounding can bring `turns` up to exactly 1.
+	i = min(i, len(t.values)-2)
+	frac := f - float32(i)
+	a := t.values[i]
+	b := t.values[i+1]
+	return a + (b-a)*frac
+}
+
+// Checks that the table length is a power of two plus one
+// and returns the number of intervals.
+func checkSize(length int) int {
+	n := length - 1
+	if n < 4 || n&(n-1) != 0 {
+		panic("lut: the table length must be a power of two plus one")
+	}
+	return n
+}
+
+const pi = 3.14159265358979323846264338327950288
+
+// Computes `sin(x)` for x in `[0, π/2]` with float64 precision.
+//
+// It's used instead of math.Sin to not pull the whole math package into the binary.
+func sinTaylor(x float64) float64 {
+	x2 := x * x
+	term := x
+	sum := x
+	for i := 2; i < 30; i += 2 {
+		term *= -x2 / float64(i*(i+1))
+		sum += term
+	}
+	return sum
+}
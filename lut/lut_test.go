@@ -0,0 +1,116 @@
+package lut_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/orsinium-labs/tinymath"
+	"github.com/orsinium-labs/tinymath/lut"
+)
+
+func close(t *testing.T, act, exp float32, eps float32) {
+	t.Helper()
+	if tinymath.IsNaN(exp) && !tinymath.IsNaN(act) {
+		t.Fatalf("%f is not NaN", act)
+	}
+	delta := tinymath.Abs(act - exp)
+	if delta > eps {
+		t.Fatalf("%f != %f", act, exp)
+	}
+}
+
+// The max errors documented in the package docs.
+var accuracy = []struct {
+	Size int
+	Eps  float64
+}{
+	{16, 1.9e-02},
+	{64, 1.3e-03},
+	{256, 7.6e-05},
+	{1024, 4.8e-06},
+	{4096, 5.2e-07},
+}
+
+func newTable(size int) lut.Table {
+	return lut.New(make([]float32, size+1))
+}
+
+// Returns the max error of Sin and Cos over `[-π, π]`.
+func maxError(table lut.Table) float64 {
+	var maxErr float64
+	const steps = 100_000
+	for i := -steps; i <= steps; i++ {
+		x := float32(i) * (math.Pi / steps)
+		s, c := table.SinCos(x)
+		maxErr = math.Max(maxErr, math.Abs(float64(s)-math.Sin(float64(x))))
+		maxErr = math.Max(maxErr, math.Abs(float64(c)-math.Cos(float64(x))))
+	}
+	return maxErr
+}
+
+func TestAccuracy(t *testing.T) {
+	t.Parallel()
+	for _, c := range accuracy {
+		c := c
+		t.Run(fmt.Sprintf("%d", c.Size), func(t *testing.T) {
+			t.Parallel()
+			e := maxError(newTable(c.Size))
+			t.Logf("size: %5d, memory: %6d B, max error: %.1e", c.Size, (c.Size+1)*4, e)
+			if e > c.Eps {
+				t.Fatalf("%.1e > %.1e", e, c.Eps)
+			}
+		})
+	}
+}
+
+func TestTable(t *testing.T) {
+	t.Parallel()
+	table := newTable(256)
+	if table.Size() != 256 {
+		t.Fatalf("size: %d", table.Size())
+	}
+	close(t, table.Sin(0), 0, 0)
+	close(t, table.Sin(tinymath.Pi/2), 1, 1e-6)
+	close(t, table.Sin(-tinymath.Pi/2), -1, 1e-6)
+	close(t, table.Cos(0), 1, 0)
+	close(t, table.Cos(tinymath.Pi), -1, 1e-6)
+	close(t, table.Tan(tinymath.Pi/4), 1, 1e-4)
+	close(t, table.Tan(-1), float32(math.Tan(-1)), 1e-3)
+	close(t, table.SinTurns(0.25), 1, 0)
+	close(t, table.SinTurns(0.75), -1, 0)
+	close(t, table.SinTurns(0.999999), 0, 1e-5)
+	close(t, table.CosTurns(0.5), -1, 0)
+	close(t, table.CosTurns(-3), 1, 0)
+	close(t, table.Sin(tinymath.NaN), tinymath.NaN, 0)
+	close(t, table.Sin(tinymath.Inf), tinymath.NaN, 0)
+	close(t, table.Cos(tinymath.NegInf), tinymath.NaN, 0)
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+	values := make([]float32, 65)
+	lut.New(values)
+	table := lut.Load(values)
+	if table.Size() != 64 {
+		t.Fatalf("size: %d", table.Size())
+	}
+	if e := maxError(table); e > 1.3e-3 {
+		t.Fatalf("max error: %.1e", e)
+	}
+}
+
+func TestInvalidSize(t *testing.T) {
+	t.Parallel()
+	for _, n := range []int{0, 1, 3, 4, 5 + 1, 100, 256} {
+		n := n
+		t.Run(fmt.Sprintf("%d", n), func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Fatal("no panic")
+				}
+			}()
+			lut.New(make([]float32, n))
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package pid
+
+import "github.com/orsinium-labs/tinymath"
+
+const fracBits = 16
+
+// Convert a float into a Q16.16 fixed-point number, the format of [FixedConfig] gains.
+func Q16(x float32) int32 {
+	return tinymath.ToInt32Sat(tinymath.Round(x * (1 << fracBits)))
+}
+
+// Configuration of [Fixed].
+//
+// Unlike [Config], there is no dt: the controller expects to be stepped
+// at a fixed rate and the time step must be baked into the gains.
+type FixedConfig struct {
+	// Proportional gain in Q16.16.
+	Kp int32
+	// Integral gain in Q16.16 multiplied by the time step (`Ki*dt`).
+	Ki int32
+	// Derivative gain in Q16.16 divided by the time step (`Kd/dt`).
+	Kd int32
+
+	// The lower limit of the output.
+	OutMin int32
+	// The upper limit of the output.
+	//
+	// If OutMax is not greater than OutMin, the output is only limited by the int32 range.
+	OutMax int32
+
+	// The strength of the derivative low-pass filter.
+	//
+	// On each step, the filtered derivative moves 1/2^FilterShift of the way
+	// towards the new raw value. Zero disables the filter.
+	FilterShift uint8
+}
+
+// PID controller in fixed-point arithmetic for chips without FPU.
+//
+// Measurements, setpoints, and outputs are plain integers (like raw ADC counts
+// and PWM duty), gains are Q16.16. Intermediate results use int64 to avoid overflows.
+// The anti-windup strategy is always [Clamping].
+type Fixed struct {
+	FixedConfig
+
+	// Q16.16
+	integral int64
+	// Q16.16
+	derivative  int64
+	prev        int32
+	initialized bool
+}
+
+// Create a new [Fixed] controller with the given configuration.
+func NewFixed(config FixedConfig) Fixed {
+	return Fixed{FixedConfig: config}
+}
+
+// Forget the accumulated integral and the previous measurement.
+func (p *Fixed) Reset() {
+	p.integral = 0
+	p.derivative = 0
+	p.initialized = false
+}
+
+// Calculate the next control output.
+func (p *Fixed) Step(setpoint, measurement int32) int32 {
+	err := int64(setpoint) - int64(measurement)
+
+	if p.initialized {
+		d := (int64(p.prev) - int64(measurement)) << fracBits
+		p.derivative += (d - p.derivative) >> p.FilterShift
+	}
+	p.prev = measurement
+	p.initialized = true
+
+	lo := int64(-1 << 31)
+	hi := int64(1<<31 - 1)
+	if p.OutMax > p.OutMin {
+		lo = int64(p.OutMin)
+		hi = int64(p.OutMax)
+	}
+
+	prevIntegral := p.integral
+	p.integral += int64(p.Ki) * err
+	p.integral = min(max(p.integral, lo<<fracBits), hi<<fracBits)
+
+	out := int64(p.Kp)*err + p.integral + (int64(p.Kd)*p.derivative)>>fracBits
+	// Round to the nearest integer.
+	out = (out + 1<<(fracBits-1)) >> fracBits
+	if out > hi || out < lo {
+		// Conditional integration, see [Clamping].
+		if (out > hi) == (err > 0) {
+			p.integral = prevIntegral
+		}
+		out = min(max(out, lo), hi)
+	}
+	return int32(out)
+}
@@ -0,0 +1,144 @@
+// Package pid provides a PID controller with output limits, integral anti-windup,
+// and a low-pass filtered derivative-on-measurement.
+package pid
+
+import "github.com/orsinium-labs/tinymath"
+
+// The strategy to prevent the integral term from growing without bounds
+// while the output is saturated.
+type AntiWindup uint8
+
+const (
+	// Stop integrating when the output is saturated and the error would
+	// push it further into saturation. The integral is also limited by the output range.
+	//
+	// This is the default.
+	Clamping AntiWindup = iota
+
+	// Feed the difference between the saturated and unsaturated output
+	// back into the integral with the [Config.Kb] gain.
+	BackCalculation
+
+	// Let the integral grow freely. Not recommended unless the output is never saturated.
+	NoAntiWindup
+)
+
+// Configuration of [PID].
+type Config struct {
+	// Proportional gain.
+	Kp float32
+	// Integral gain.
+	Ki float32
+	// Derivative gain.
+	Kd float32
+
+	// The lower limit of the output.
+	OutMin float32
+	// The upper limit of the output.
+	//
+	// If OutMax is not greater than OutMin, the output is not limited.
+	OutMax float32
+
+	// How to prevent the integral windup.
+	AntiWindup AntiWindup
+
+	// The tracking gain for [BackCalculation].
+	//
+	// If zero, `Ki/Kp` is used which is a common rule of thumb.
+	Kb float32
+
+	// The time constant (in the same units as dt) of the first-order low-pass
+	// filter applied to the derivative term.
+	//
+	// If zero, the derivative is not filtered.
+	Tf float32
+}
+
+// PID controller.
+//
+// The derivative is calculated from the measurement rather than from the error
+// to avoid the "derivative kick" when the setpoint changes.
+type PID struct {
+	Config
+
+	integral    float32
+	derivative  float32
+	prev        float32
+	initialized bool
+}
+
+// Create a new [PID] controller with the given configuration.
+func New(config Config) PID {
+	return PID{Config: config}
+}
+
+// Forget the accumulated integral and the previous measurement.
+func (p *PID) Reset() {
+	p.integral = 0
+	p.derivative = 0
+	p.initialized = false
+}
+
+// The current value of the integral term.
+func (p *PID) Integral() float32 {
+	return p.integral
+}
+
+// Calculate the next control output.
+//
+// The `dt` is the time passed since the previous step. It must be positive.
+func (p *PID) Step(setpoint, measurement, dt float32) float32 {
+	err := setpoint - measurement
+
+	// The derivative of the measurement passed through a low-pass filter.
+	// On the first step, there is no previous measurement, so it is zero.
+	if p.initialized {
+		d := -(measurement - p.prev) / dt
+		if p.Tf > 0 {
+			p.derivative += (d - p.derivative) * dt / (p.Tf + dt)
+		} else {
+			p.derivative = d
+		}
+	}
+	p.prev = measurement
+	p.initialized = true
+
+	prevIntegral := p.integral
+	p.integral += p.Ki * err * dt
+	if p.AntiWindup == Clamping && p.limited() {
+		p.integral = p.clamp(p.integral)
+	}
+
+	out := p.Kp*err + p.integral + p.Kd*p.derivative
+	if !p.limited() {
+		return out
+	}
+	sat := p.clamp(out)
+	if sat == out {
+		return out
+	}
+
+	switch p.AntiWindup {
+	case Clamping:
+		// Conditional integration: if the error drives the output further
+		// into saturation, discard the integration of this step.
+		if (out > sat) == (err > 0) {
+			p.integral = prevIntegral
+		}
+	case BackCalculation:
+		kb := p.Kb
+		if kb == 0 && p.Kp != 0 {
+			kb = p.Ki / p.Kp
+		}
+		p.integral += kb * (sat - out) * dt
+	}
+	return sat
+}
+
+func (p *PID) limited() bool {
+	return p.OutMax > p.OutMin
+}
+
+func (p *PID) clamp(x float32) float32 {
+	return tinymath.Min(tinymath.Max(x, p.OutMin), p.OutMax)
+}
@@ -0,0 +1,195 @@
+package pid_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/orsinium-labs/tinymath"
+	"github.com/orsinium-labs/tinymath/pid"
+)
+
+// A first-order plant: `tau * dy/dt = gain * u - y`.
+type plant struct {
+	y    float32
+	gain float32
+	tau  float32
+}
+
+func (p *plant) step(u, dt float32) float32 {
+	p.y += (p.gain*u - p.y) * dt / p.tau
+	return p.y
+}
+
+// Runs the closed loop for the given duration
+// and returns the final output of the plant and the max overshoot.
+func simulate(c *pid.PID, setpoint, duration float32) (float32, float32) {
+	const dt = 0.01
+	p := plant{gain: 2, tau: 0.5}
+	var peak float32
+	for t := float32(0); t < duration; t += dt {
+		u := c.Step(setpoint, p.y, dt)
+		p.step(u, dt)
+		peak = tinymath.Max(peak, p.y)
+	}
+	return p.y, peak - setpoint
+}
+
+func TestPIDSettles(t *testing.T) {
+	t.Parallel()
+	configs := []pid.Config{
+		{Kp: 2, Ki: 4},
+		{Kp: 2, Ki: 4, Kd: 0.05},
+		{Kp: 2, Ki: 4, Kd: 0.05, Tf: 0.05},
+		{Kp: 2, Ki: 4, OutMin: -10, OutMax: 10},
+		{Kp: 2, Ki: 4, OutMin: -10, OutMax: 10, AntiWindup: pid.BackCalculation},
+	}
+	for i, cfg := range configs {
+		cfg := cfg
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			c := pid.New(cfg)
+			y, _ := simulate(&c, 5, 10)
+			if tinymath.Abs(y-5) > 0.05 {
+				t.Fatalf("%f != 5", y)
+			}
+		})
+	}
+}
+
+func TestPIDOutputLimits(t *testing.T) {
+	t.Parallel()
+	c := pid.New(pid.Config{Kp: 100, Ki: 100, OutMin: -1, OutMax: 1})
+	for i := 0; i < 100; i++ {
+		out := c.Step(1000, 0, 0.01)
+		if out != 1 {
+			t.Fatalf("%f != 1", out)
+		}
+	}
+	out := c.Step(-1000, 0, 0.01)
+	if out != -1 {
+		t.Fatalf("%f != -1", out)
+	}
+}
+
+func TestPIDAntiWindup(t *testing.T) {
+	t.Parallel()
+	// The setpoint can be reached only with u=4.5 but it's limited to 5,
+	// so the integral will wind up while the plant is slowly approaching the target.
+	overshoot := func(mode pid.AntiWindup) float32 {
+		c := pid.New(pid.Config{Kp: 1, Ki: 10, OutMin: 0, OutMax: 5, AntiWindup: mode})
+		_, o := simulate(&c, 9, 10)
+		return o
+	}
+	none := overshoot(pid.NoAntiWindup)
+	clamping := overshoot(pid.Clamping)
+	back := overshoot(pid.BackCalculation)
+	if none < 0.5 {
+		t.Fatalf("no windup without anti-windup: %f", none)
+	}
+	if clamping > none/2 {
+		t.Fatalf("clamping: %f > %f/2", clamping, none)
+	}
+	if back > none/2 {
+		t.Fatalf("back-calculation: %f > %f/2", back, none)
+	}
+}
+
+func TestPIDDerivativeOnMeasurement(t *testing.T) {
+	t.Parallel()
+	c := pid.New(pid.Config{Kd: 1})
+	c.Step(0, 3, 0.1)
+	// A setpoint change does not cause a derivative kick.
+	out := c.Step(100, 3, 0.1)
+	if out != 0 {
+		t.Fatalf("%f != 0", out)
+	}
+	// A measurement change does.
+	out = c.Step(100, 4, 0.1)
+	if tinymath.Abs(out-(-10)) > 1e-4 {
+		t.Fatalf("%f != -10", out)
+	}
+}
+
+func TestPIDDerivativeFilter(t *testing.T) {
+	t.Parallel()
+	raw := pid.New(pid.Config{Kd: 1})
+	filtered := pid.New(pid.Config{Kd: 1, Tf: 0.1})
+	raw.Step(0, 0, 0.01)
+	filtered.Step(0, 0, 0.01)
+	// A single-step spike of noise in the measurement.
+	r := raw.Step(0, 1, 0.01)
+	f := filtered.Step(0, 1, 0.01)
+	if tinymath.Abs(f) > tinymath.Abs(r)/5 {
+		t.Fatalf("%f is not filtered (raw: %f)", f, r)
+	}
+}
+
+func TestPIDReset(t *testing.T) {
+	t.Parallel()
+	c := pid.New(pid.Config{Ki: 1})
+	c.Step(1, 0, 1)
+	if c.Integral() != 1 {
+		t.Fatalf("%f != 1", c.Integral())
+	}
+	c.Reset()
+	if c.Integral() != 0 {
+		t.Fatalf("%f != 0", c.Integral())
+	}
+}
+
+func TestFixedSettles(t *testing.T) {
+	t.Parallel()
+	const dt = 0.01
+	// The sensor reports the plant state in millis.
+	c := pid.NewFixed(pid.FixedConfig{
+		Kp:          pid.Q16(2.0 / 1000),
+		Ki:          pid.Q16(4.0 / 1000 * dt),
+		OutMin:      -10,
+		OutMax:      10,
+		FilterShift: 2,
+	})
+	// The output is an integer, so use a plant that can be settled with an integer input.
+	p := plant{gain: 1, tau: 0.5}
+	var u int32
+	for i := 0; i < 1000; i++ {
+		u = c.Step(5000, int32(p.y*1000))
+		p.step(float32(u), dt)
+	}
+	if tinymath.Abs(p.y-5) > 0.01 || u != 5 {
+		t.Fatalf("%f != 5 (u=%d)", p.y, u)
+	}
+}
+
+func TestFixedOutputLimits(t *testing.T) {
+	t.Parallel()
+	c := pid.NewFixed(pid.FixedConfig{Kp: pid.Q16(100), Ki: pid.Q16(10), OutMin: -1000, OutMax: 1000})
+	for i := 0; i < 100; i++ {
+		out := c.Step(1000, 0)
+		if out != 1000 {
+			t.Fatalf("%d != 1000", out)
+		}
+	}
+	if out := c.Step(-1000, 0); out != -1000 {
+		t.Fatalf("%d != -1000", out)
+	}
+}
+
+func TestFixedMatchesFloat(t *testing.T) {
+	t.Parallel()
+	const dt = 0.01
+	f := pid.New(pid.Config{Kp: 1.5, Ki: 3, Kd: 0.02, OutMin: -1000, OutMax: 1000})
+	x := pid.NewFixed(pid.FixedConfig{
+		Kp:     pid.Q16(1.5),
+		Ki:     pid.Q16(3 * dt),
+		Kd:     pid.Q16(0.02 / dt),
+		OutMin: -1000,
+		OutMax: 1000,
+	})
+	for i := int32(0); i < 100; i++ {
+		m := (i * 7) % 23
+		fo := f.Step(50, float32(m), dt)
+		xo := x.Step(50, m)
+		if tinymath.Abs(fo-float32(xo)) > 1 {
+			t.Fatalf("step %d: %f != %d", i, fo, xo)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package tinymath
+
+// Polynomial evaluation.
+//
+// Coefficients are ordered from the lowest degree:
+// `[c0, c1, c2]` is the polynomial `c0 + c1*x + c2*x^2`.
+// Keep coefficients in a fixed-size array and pass it as a slice (`coeffs[:]`),
+// the evaluation doesn't allocate.
+
+// Evaluates the polynomial with the given coefficients at `self` using Horner's method.
+//
+// It uses the smallest number of operations but each step depends on the previous one.
+// Returns 0 if there are no coefficients.
+func Horner(self float32, coeffs []float32) float32 {
+	if len(coeffs) == 0 {
+		return 0
+	}
+	i := len(coeffs) - 1
+	r := coeffs[i]
+	for i--; i >= 0; i-- {
+		r = r*self + coeffs[i]
+	}
+	return r
+}
+
+// Evaluates the polynomial with the given coefficients at `self` using Estrin's scheme.
+//
+// It does a few more multiplications than [Horner] but they are independent,
+// so a CPU with a pipelined FPU (or the compiler) can run them in parallel.
+// For high-degree polynomials, it's also a bit more precise.
+// Returns 0 if there are no coefficients.
+func Estrin(self float32, coeffs []float32) float32 {
+	n := len(coeffs)
+	switch n {
+	case 0:
+		return 0
+	case 1:
+		return coeffs[0]
+	case 2:
+		return coeffs[0] + coeffs[1]*self
+	}
+	// p(x) = low(x) + x^k * high(x), where k is the largest power of two less than n.
+	k := 1 << ILog2(int32(n-1))
+	xk := self
+	for i := 1; i < k; i *= 2 {
+		xk *= xk
+	}
+	return Estrin(self, coeffs[:k]) + xk*Estrin(self, coeffs[k:])
+}
+
+// Evaluates the rational function `P(self) / Q(self)`,
+// where `p` and `q` are coefficients of the polynomials P and Q.
+//
+// Rational functions approximate functions with poles or asymptotes
+// (like tan or atan) much better than polynomials of the same degree.
+func Rational(self float32, p, q []float32) float32 {
+	return Horner(self, p) / Horner(self, q)
+}
+
+// Evaluates the Chebyshev series `c0*T0(x) + c1*T1(x) + ...` using the Clenshaw algorithm.
+//
+// `self` must be in the `[-1, 1]` range. For an approximation on `[a, b]`,
+// map the argument first: `(2*x - a - b) / (b - a)`.
+//
+// Chebyshev coefficients are bounded and usually decrease fast,
+// so the series can be truncated to trade precision for speed.
+func Chebyshev(self float32, coeffs []float32) float32 {
+	var b1, b2 float32
+	x2 := 2 * self
+	for i := len(coeffs) - 1; i >= 1; i-- {
+		b1, b2 = coeffs[i]+x2*b1-b2, b1
+	}
+	if len(coeffs) == 0 {
+		return 0
+	}
+	return coeffs[0] + self*b1 - b2
+}
@@ -0,0 +1,105 @@
+package tinymath_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/orsinium-labs/tinymath"
+)
+
+// Evaluates the polynomial directly in float64, term by term.
+//
+// Also returns the sum of absolute values of all terms,
+// the scale of rounding errors for the polynomial.
+func polyRef(x float32, coeffs []float32) (float32, float32) {
+	var r, m float64
+	for i, c := range coeffs {
+		term := float64(c) * math.Pow(float64(x), float64(i))
+		r += term
+		m += math.Abs(term)
+	}
+	return float32(r), float32(m)
+}
+
+var polyCoeffs = [][]float32{
+	{},
+	{3},
+	{1, 2},
+	{1, -2, 3},
+	{0.5, 0.25, -1, 2},
+	{1, 1, 0.5, 1.0 / 6, 1.0 / 24, 1.0 / 120},
+	{8, -28, 56, -70, 56, -28, 8, -1},
+	{1, -1, 1, -1, 1, -1, 1, -1, 1, -1, 1, -1, 1},
+}
+
+func TestHorner(t *testing.T) {
+	t.Parallel()
+	for _, coeffs := range polyCoeffs {
+		for _, x := range []float32{-2, -1, -0.3, 0, 0.5, 1, 1.7} {
+			coeffs, x := coeffs, x
+			t.Run(fmt.Sprintf("%v_%f", coeffs, x), func(t *testing.T) {
+				exp, scale := polyRef(x, coeffs)
+				eps := 1e-7 * tinymath.Max(1, scale) * float32(len(coeffs)+1)
+				close(t, tinymath.Horner(x, coeffs), exp, eps)
+				close(t, tinymath.Estrin(x, coeffs), exp, eps)
+			})
+		}
+	}
+}
+
+func TestHornerExact(t *testing.T) {
+	t.Parallel()
+	// 1 + 2x + 3x^2 at x=2
+	eq(t, tinymath.Horner(2, []float32{1, 2, 3}), 17)
+	eq(t, tinymath.Estrin(2, []float32{1, 2, 3}), 17)
+	// (x+1)^4 at x=1
+	eq(t, tinymath.Estrin(1, []float32{1, 4, 6, 4, 1}), 16)
+	eq(t, tinymath.Horner(5, nil), 0)
+	eq(t, tinymath.Estrin(5, nil), 0)
+}
+
+func TestRational(t *testing.T) {
+	t.Parallel()
+	// (1 + x) / (1 - x)
+	p := []float32{1, 1}
+	q := []float32{1, -1}
+	for _, x := range []float32{-0.5, 0, 0.25, 0.5, 3} {
+		close(t, tinymath.Rational(x, p, q), (1+x)/(1-x), 1e-6)
+	}
+	// The Padé approximant of e^x: (1 + x/2 + x^2/12) / (1 - x/2 + x^2/12)
+	p = []float32{1, 0.5, 1.0 / 12}
+	q = []float32{1, -0.5, 1.0 / 12}
+	close(t, tinymath.Rational(0.1, p, q), float32(math.Exp(0.1)), 1e-6)
+}
+
+// Computes the Chebyshev polynomial of the first kind using its definition.
+func chebyshevT(k int, x float32) float64 {
+	return math.Cos(float64(k) * math.Acos(float64(x)))
+}
+
+func TestChebyshev(t *testing.T) {
+	t.Parallel()
+	for _, coeffs := range polyCoeffs {
+		for _, x := range []float32{-1, -0.7, -0.1, 0, 0.3, 0.9, 1} {
+			coeffs, x := coeffs, x
+			t.Run(fmt.Sprintf("%v_%f", coeffs, x), func(t *testing.T) {
+				var exp float64
+				for k, c := range coeffs {
+					exp += float64(c) * chebyshevT(k, x)
+				}
+				close(t, tinymath.Chebyshev(x, coeffs), float32(exp), 1e-5*float32(len(coeffs)+1))
+			})
+		}
+	}
+}
+
+func TestChebyshevApprox(t *testing.T) {
+	t.Parallel()
+	// The first terms of the Chebyshev expansion of e^x on [-1, 1].
+	coeffs := []float32{1.2660659, 1.1303182, 0.27149534, 0.044336851, 0.0054742404, 0.00054292631, 0.000044977322}
+	for i := -10; i <= 10; i++ {
+		x := float32(i) / 10
+		close(t, tinymath.Chebyshev(x, coeffs), float32(math.Exp(float64(x))), 5e-6)
+	}
+}
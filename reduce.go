@@ -0,0 +1,108 @@
+package tinymath
+
+// Range reduction for trigonometric functions.
+//
+// Sin, Cos, and friends approximate the function for an angle in turns,
+// so the angle in radians first needs to be reduced modulo 2π. Doing it with
+// a single float32 multiplication by 1/(2π) loses precision: the rounding error
+// of the product is proportional to the angle and at 1e6 radians
+// it's already about 0.03 radians.
+//
+// For small angles, up to [directMax], the rounding error of that product
+// is still much smaller than the error of the approximation, so we do just that.
+//
+// For angles up to [codyWaiteMax], we use Cody-Waite reduction:
+// the angle is reduced by a multiple of π/2 using π/2 split into 3 parts.
+// The first two parts have only 12 significant bits, so multiplying them by
+// the (small) number of quadrants is exact.
+//
+// For bigger angles, we use Payne-Hanek reduction: only the bits of 1/(2π)
+// that affect the fractional part of the product are multiplied, in integers.
+// This is exact for any finite float32.
+//
+// Infinities and NaN don't have a meaningful angle, and the result for them is NaN.
+
+// The max absolute angle in radians that is multiplied by 1/(2π) directly.
+//
+// The product is below 41 turns, so its rounding error is below 2^-19 turns (1.2e-5 radians).
+const directMax = 256
+
+// The max absolute angle in radians for Cody-Waite reduction.
+//
+// The number of quadrants must fit into 12 bits for `k*pio2Hi` and `k*pio2Mid` to be exact.
+const codyWaiteMax = 4096
+
+// π/2 split into 3 parts. The first two have 12 significant bits.
+const (
+	pio2Hi  float32 = 1.5703125
+	pio2Mid float32 = 4.837512969970703125e-4
+	pio2Lo  float32 = 7.54978995489188216e-8
+)
+
+// The first 192 bits of 1/(2π) after the binary point.
+var invTauBits = [...]uint32{
+	0x28be60db, 0x9391054a, 0x7f09d5f4, 0x7d4d3770, 0x36d8a566, 0x4f10e410,
+}
+
+// Converts an angle in radians into turns, reduced to a small range around zero.
+//
+// The result is below 41 turns in magnitude, so [cosTurns] can reduce it further
+// without losing precision. It points in the same direction as `x`.
+func reduceTurns(x float32) float32 {
+	a := Abs(x)
+	if a <= directMax {
+		return x * (Frac1Pi / 2.0)
+	}
+	// Also catches NaN.
+	if !(a <= codyWaiteMax) {
+		return payneHanek(x)
+	}
+	// x = k*(π/2) + r, where r is in (-π/2, π/2).
+	// Any k close to x/(π/2) works, so it's truncated, which is cheaper than rounding.
+	k := int32(x * Frac2Pi)
+	kf := float32(k)
+	r := x - kf*pio2Hi - kf*pio2Mid - kf*pio2Lo
+	// The quarter of a turn for each quadrant, modulo a full turn.
+	q := float32(k&3) * 0.25
+	return r*(Frac1Pi/2.0) + q
+}
+
+// Returns the fractional part of `x/(2π)` with the sign of `x`.
+//
+// Returns NaN for infinities and NaN. `x` must not be subnormal.
+func payneHanek(x float32) float32 {
+	bits := ToBits(x)
+	exp := int32(extractExponentBits(x))
+	if exp == 0xff {
+		return NaN
+	}
+	// x = m * 2^e, where m is an integer.
+	m := uint64(bits&^signMask&^expMask | 1<<mantissaBits)
+	e := exp - expBias - mantissaBits
+	// The bits of 1/(2π) with values above 2^-(e+1) multiplied by m
+	// give whole turns and can be skipped. The bits after the next 64 ones
+	// change the result by less than 2^-40.
+	w := invTauWindow(e + 1)
+	// The fractional part of m*w*2^-64 is the low 64 bits of the product,
+	// and the integer multiplication wraps around to exactly them.
+	frac := m * w
+	t := float32(frac>>40) * (1.0 / (1 << 24))
+	if bits&signMask != 0 {
+		return -t
+	}
+	return t
+}
+
+// Returns 64 bits of 1/(2π), starting from the bit with the value 2^-pos.
+func invTauWindow(pos int32) uint64 {
+	if pos < 1 {
+		// 1/(2π) < 0.5, so all bits before the table are zeros.
+		w := uint64(invTauBits[0])<<32 | uint64(invTauBits[1])
+		return w >> (1 - pos)
+	}
+	i := (pos - 1) / 32
+	off := (pos - 1) % 32
+	hi := uint64(invTauBits[i])<<32 | uint64(invTauBits[i+1])
+	lo := uint64(invTauBits[i+2])
+	return hi<<off | lo>>(32-off)
+}
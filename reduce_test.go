@@ -0,0 +1,116 @@
+package tinymath_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/orsinium-labs/tinymath"
+)
+
+// The max error of the cosine approximation itself,
+// which must not grow with the angle.
+const trigMaxErr = 0.0012
+
+func checkSinCos(t *testing.T, x float32) {
+	t.Helper()
+	sin, cos := math.Sincos(float64(x))
+	s, c := tinymath.SinCos(x)
+	if math.Abs(float64(tinymath.Sin(x))-sin) > trigMaxErr {
+		t.Fatalf("Sin(%g) = %g, want %g", x, tinymath.Sin(x), sin)
+	}
+	if math.Abs(float64(tinymath.Cos(x))-cos) > trigMaxErr {
+		t.Fatalf("Cos(%g) = %g, want %g", x, tinymath.Cos(x), cos)
+	}
+	same(t, s, tinymath.Sin(x))
+	same(t, c, tinymath.Cos(x))
+}
+
+func TestSinCosLargeAngles(t *testing.T) {
+	t.Parallel()
+	// Long-running oscillators: the phase grows up to 1e6 radians.
+	for i := 0; i <= 200_000; i++ {
+		x := float32(i) * 5
+		checkSinCos(t, x)
+		checkSinCos(t, -x)
+		checkSinCos(t, x+0.1234)
+	}
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100_000; i++ {
+		checkSinCos(t, float32(r.Float64()*2e6-1e6))
+	}
+}
+
+func TestSinCosHugeAngles(t *testing.T) {
+	t.Parallel()
+	samples := []float32{
+		255.9, 256, tinymath.Nextup(256), 300, -300,
+		4095.9, 4096, tinymath.Nextup(4096), 4097, -4097, 8192.5,
+		1 << 23, 1<<23 + 1, 1 << 24, 123456789, 1e10, -1e10, 3e15, 1e20, 1e30, -1e38,
+		tinymath.MaxPos, tinymath.MinNeg,
+	}
+	for _, x := range samples {
+		x := x
+		t.Run(fmt.Sprintf("%g", x), func(t *testing.T) {
+			checkSinCos(t, x)
+		})
+	}
+	// Every power of two has a different window of 1/(2π) bits.
+	for e := 12; e < 128; e++ {
+		x := float32(math.Ldexp(1.2345, e))
+		checkSinCos(t, x)
+		checkSinCos(t, tinymath.Nextup(x))
+	}
+}
+
+func TestSinCosSpecial(t *testing.T) {
+	t.Parallel()
+	for _, x := range []float32{tinymath.NaN, tinymath.Inf, tinymath.NegInf} {
+		s, c := tinymath.SinCos(x)
+		for _, v := range []float32{tinymath.Sin(x), tinymath.Cos(x), tinymath.Tan(x), s, c} {
+			if !tinymath.IsNaN(v) {
+				t.Fatalf("%g: %g is not NaN", x, v)
+			}
+		}
+	}
+	// Zero and the key angles stay exact.
+	eq(t, tinymath.Sin(0), 0)
+	eq(t, tinymath.Cos(0), 1)
+	eq(t, tinymath.Cos(tinymath.Pi), -1)
+	eq(t, tinymath.Sin(tinymath.FracPi2), 1)
+}
+
+func TestTanLargeAngles(t *testing.T) {
+	t.Parallel()
+	for i := 0; i <= 10_000; i++ {
+		x := float32(i)*100 + 0.5
+		exp := math.Tan(float64(x))
+		act := float64(tinymath.Tan(x))
+		// The error is relative for big values close to the poles.
+		if math.Abs(act-exp) > 0.02*max(1, math.Abs(exp)) {
+			t.Fatalf("Tan(%g) = %g, want %g", x, act, exp)
+		}
+	}
+}
+
+func TestSliceLargeAngles(t *testing.T) {
+	t.Parallel()
+	// Blocks with all values in the same reduction range and mixed blocks.
+	src := []float32{
+		1, -2, 3, 250,
+		300, -400, 1000, 4000,
+		5000, 1e6, -1e6, 3e30,
+		1, 300, 5000, 2,
+		-256, 256, 4096, -4096,
+		tinymath.Nextup(256), 4096, 257, -258,
+	}
+	sin := make([]float32, len(src))
+	cos := make([]float32, len(src))
+	tinymath.SinSlice(sin, src)
+	tinymath.CosSlice(cos, src)
+	for i, x := range src {
+		same(t, sin[i], tinymath.Sin(x))
+		same(t, cos[i], tinymath.Cos(x))
+	}
+}
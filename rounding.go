@@ -0,0 +1,115 @@
+package tinymath
+
+// The smallest float32 that has no fractional part, 2^23.
+const noFractBound = 1 << mantissaBits
+
+// Returns the nearest integer to a number, rounding half-way cases away from zero.
+//
+// Special cases are:
+//
+//   - `Round(±0) = ±0`
+//   - `Round(±Inf) = ±Inf`
+//   - `Round(NaN) = NaN`
+func Round(self float32) float32 {
+	t := Trunc(self)
+	// The subtraction is exact, so there is no double rounding.
+	if Abs(self-t) >= 0.5 {
+		return t + CopySign(1, self)
+	}
+	return t
+}
+
+// Returns the nearest integer to a number, rounding half-way cases to even
+// (also known as banker's rounding).
+//
+// Unlike [Round], it has no bias when rounding many numbers.
+// Special cases are the same as for [Round].
+func RoundToEven(self float32) float32 {
+	t := Trunc(self)
+	d := Abs(self - t)
+	// If there is a fraction, the number is small enough to fit into int32.
+	if d > 0.5 || (d == 0.5 && int32(t)&1 != 0) {
+		return t + CopySign(1, self)
+	}
+	return t
+}
+
+// Rounds the number up or down with the probability proportional to the distance
+// to the nearest integers.
+//
+// The `rand` must be a uniformly distributed random number in the `[0, 1)` range.
+// Then, on average, the rounded value is equal to the original one.
+// It's used for quantization: to reduce bit depth of audio samples,
+// pixels, or neural network weights without introducing a bias.
+func RoundStochastic(self float32, rand float32) float32 {
+	if Abs(self) >= noFractBound {
+		return self
+	}
+	f := Floor(self)
+	if self-f > rand {
+		return f + 1
+	}
+	return f
+}
+
+// Converts the number to int32, truncating towards zero
+// and saturating at the bounds of int32.
+//
+// NaN is converted to zero. Use [Round] first to get the nearest integer instead.
+func ToInt32Sat(self float32) int32 {
+	switch {
+	case IsNaN(self):
+		return 0
+	case self >= 1<<31:
+		return 1<<31 - 1
+	case self <= -1<<31:
+		return -1 << 31
+	}
+	return int32(self)
+}
+
+// Converts the number to uint32, truncating towards zero
+// and saturating at the bounds of uint32.
+//
+// See [ToInt32Sat].
+func ToUint32Sat(self float32) uint32 {
+	switch {
+	case IsNaN(self) || self <= 0:
+		return 0
+	case self >= 1<<32:
+		return 1<<32 - 1
+	}
+	return uint32(self)
+}
+
+// Converts the number to int16, truncating towards zero
+// and saturating at the bounds of int16.
+//
+// See [ToInt32Sat].
+func ToInt16Sat(self float32) int16 {
+	return int16(ToInt32Sat(Clamp(self, -1<<15, 1<<15-1)))
+}
+
+// Converts the number to uint16, truncating towards zero
+// and saturating at the bounds of uint16.
+//
+// See [ToInt32Sat].
+func ToUint16Sat(self float32) uint16 {
+	return uint16(ToInt32Sat(Clamp(self, 0, 1<<16-1)))
+}
+
+// Converts the number to int8, truncating towards zero
+// and saturating at the bounds of int8.
+//
+// See [ToInt32Sat].
+func ToInt8Sat(self float32) int8 {
+	return int8(ToInt32Sat(Clamp(self, -1<<7, 1<<7-1)))
+}
+
+// Converts the number to uint8, truncating towards zero
+// and saturating at the bounds of uint8.
+//
+// See [ToInt32Sat].
+func ToUint8Sat(self float32) uint8 {
+	return uint8(ToInt32Sat(Clamp(self, 0, 1<<8-1)))
+}
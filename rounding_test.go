@@ -0,0 +1,143 @@
+package tinymath_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/orsinium-labs/tinymath"
+)
+
+func TestRound(t *testing.T) {
+	t.Parallel()
+	cases := []Case{
+		{0.0, 0.0},
+		{0.49999, 0.0},
+		{-0.49999, 0.0},
+		{0.5, 1.0},
+		{-0.5, -1.0},
+		{9999.499, 9999.0},
+		{-9999.499, -9999.0},
+		{9999.5, 10000.0},
+		{-9999.5, -10000.0},
+		{0.49999997, 0.0},
+		{-0.49999997, 0.0},
+		{8388609.0, 8388609.0},
+		{3e9, 3e9},
+		{-3e9, -3e9},
+		{1e30, 1e30},
+		{tinymath.Inf, tinymath.Inf},
+		{tinymath.NegInf, tinymath.NegInf},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("%f", c.Given), func(t *testing.T) {
+			act := tinymath.Round(c.Given)
+			eq(t, act, c.Expected)
+		})
+	}
+
+	for i := float32(-20.); i < 20.; i += .34 {
+		i := i
+		t.Run(fmt.Sprintf("%f", i), func(t *testing.T) {
+			eq(t, tinymath.Round(i), float32(math.Round(float64(i))))
+		})
+	}
+}
+
+func TestRoundStdlib(t *testing.T) {
+	t.Parallel()
+	for _, x := range decomposeSamples {
+		x := x
+		t.Run(fmt.Sprintf("%g", x), func(t *testing.T) {
+			same(t, tinymath.Round(x), float32(math.Round(float64(x))))
+			same(t, tinymath.RoundToEven(x), float32(math.RoundToEven(float64(x))))
+		})
+	}
+	// All halves and their neighbours up to 2^24.
+	for i := int32(-1 << 12); i < 1<<12; i++ {
+		for _, x := range []float32{float32(i) + 0.5, float32(i<<12) + 0.5, float32(i<<12) + 1.5} {
+			for _, y := range []float32{tinymath.Nextdown(x), x, tinymath.Nextup(x)} {
+				same(t, tinymath.Round(y), float32(math.Round(float64(y))))
+				same(t, tinymath.RoundToEven(y), float32(math.RoundToEven(float64(y))))
+			}
+		}
+	}
+}
+
+func TestRoundToEven(t *testing.T) {
+	t.Parallel()
+	cases := []Case{
+		{0.5, 0.0},
+		{1.5, 2.0},
+		{2.5, 2.0},
+		{-0.5, 0.0},
+		{-1.5, -2.0},
+		{-2.5, -2.0},
+		{2.5000002, 3.0},
+		{0.49999997, 0.0},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("%f", c.Given), func(t *testing.T) {
+			eq(t, tinymath.RoundToEven(c.Given), c.Expected)
+		})
+	}
+}
+
+func TestRoundStochastic(t *testing.T) {
+	t.Parallel()
+	eq(t, tinymath.RoundStochastic(2.25, 0.2), 3)
+	eq(t, tinymath.RoundStochastic(2.25, 0.3), 2)
+	eq(t, tinymath.RoundStochastic(-2.25, 0.7), -2)
+	eq(t, tinymath.RoundStochastic(-2.25, 0.8), -3)
+	eq(t, tinymath.RoundStochastic(7, 0), 7)
+	eq(t, tinymath.RoundStochastic(1e20, 0.5), 1e20)
+
+	// With uniformly distributed random numbers, the mean is preserved.
+	for _, x := range []float32{0.1, 0.5, 3.3, -7.9} {
+		const n = 1000
+		var sum float32
+		for i := 0; i < n; i++ {
+			sum += tinymath.RoundStochastic(x, (float32(i)+0.5)/n)
+		}
+		close(t, sum/n, x, 0.001)
+	}
+}
+
+func TestToIntSat(t *testing.T) {
+	t.Parallel()
+	samples := []float32{
+		tinymath.NaN, tinymath.NegInf, -1e20, -3e9, -2147483648, -70000, -32768.5, -300, -128.9, -1.5, -0.9,
+		0, 0.9, 1.5, 127.9, 255.9, 300, 32767.9, 65535.9, 70000, 2147483520, 3e9, 5e9, 1e20, tinymath.Inf,
+	}
+	sat := func(x float32, min, max float64) float64 {
+		if tinymath.IsNaN(x) {
+			return 0
+		}
+		return math.Max(min, math.Min(max, math.Trunc(float64(x))))
+	}
+	for _, x := range samples {
+		x := x
+		t.Run(fmt.Sprintf("%g", x), func(t *testing.T) {
+			if act, exp := tinymath.ToInt32Sat(x), sat(x, math.MinInt32, math.MaxInt32); float64(act) != exp {
+				t.Fatalf("int32: %d != %f", act, exp)
+			}
+			if act, exp := tinymath.ToUint32Sat(x), sat(x, 0, math.MaxUint32); float64(act) != exp {
+				t.Fatalf("uint32: %d != %f", act, exp)
+			}
+			if act, exp := tinymath.ToInt16Sat(x), sat(x, math.MinInt16, math.MaxInt16); float64(act) != exp {
+				t.Fatalf("int16: %d != %f", act, exp)
+			}
+			if act, exp := tinymath.ToUint16Sat(x), sat(x, 0, math.MaxUint16); float64(act) != exp {
+				t.Fatalf("uint16: %d != %f", act, exp)
+			}
+			if act, exp := tinymath.ToInt8Sat(x), sat(x, math.MinInt8, math.MaxInt8); float64(act) != exp {
+				t.Fatalf("int8: %d != %f", act, exp)
+			}
+			if act, exp := tinymath.ToUint8Sat(x), sat(x, 0, math.MaxUint8); float64(act) != exp {
+				t.Fatalf("uint8: %d != %f", act, exp)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package sensor
+
+import "github.com/orsinium-labs/tinymath"
+
+// Linear calibration: `value = raw*Gain + Offset`.
+type TwoPoint struct {
+	Gain   float32
+	Offset float32
+}
+
+// Calculates the linear calibration from two raw readings
+// and the reference values they should be mapped to.
+//
+// The raw readings must be different.
+func FitTwoPoint(raw1, ref1, raw2, ref2 float32) TwoPoint {
+	gain := (ref2 - ref1) / (raw2 - raw1)
+	return TwoPoint{Gain: gain, Offset: ref1 - raw1*gain}
+}
+
+// Converts a raw reading into the calibrated value.
+func (c TwoPoint) Apply(raw float32) float32 {
+	return raw*c.Gain + c.Offset
+}
+
+// Polynomial calibration.
+//
+// Coefficients are ordered from the lowest degree, the same as for [tinymath.Horner].
+type Polynomial []float32
+
+// Converts a raw reading into the calibrated value.
+func (p Polynomial) Apply(raw float32) float32 {
+	return tinymath.Horner(raw, p)
+}
+
+// Fits the polynomial of the given degree to the raw readings
+// and the reference values using the least squares method.
+//
+// The fitting is done in float64 because the normal equations for raw ADC counts
+// don't fit into float32 precision. It's slow on microcontrollers
+// but needs to be done only once per calibration.
+//
+// Returns nil if the degree is negative, the slices have different lengths,
+// or there are fewer distinct readings than `degree+1`.
+func FitPolynomial(raw, ref []float32, degree int) Polynomial {
+	n := degree + 1
+	if degree < 0 || len(raw) != len(ref) || len(raw) < n {
+		return nil
+	}
+	// Scale the readings into `[-1, 1]` to keep the matrix well-conditioned.
+	var scale float64
+	for _, x := range raw {
+		scale = max(scale, float64(tinymath.Abs(x)))
+	}
+	if scale == 0 {
+		scale = 1
+	}
+
+	// The augmented matrix of the normal equations.
+	m := make([][]float64, n)
+	for i := range m {
+		m[i] = make([]float64, n+1)
+	}
+	for k, x := range raw {
+		x := float64(x) / scale
+		y := float64(ref[k])
+		xi := 1.0
+		for i := 0; i < n; i++ {
+			xj := xi * xi
+			for j := i; j < n; j++ {
+				m[i][j] += xj
+				xj *= x
+			}
+			m[i][n] += xi * y
+			xi *= x
+		}
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			m[i][j] = m[j][i]
+		}
+	}
+
+	// Gaussian elimination with partial pivoting.
+	for col := 0; col < n; col++ {
+		pivot := col
+		for row := col + 1; row < n; row++ {
+			if abs64(m[row][col]) > abs64(m[pivot][col]) {
+				pivot = row
+			}
+		}
+		m[col], m[pivot] = m[pivot], m[col]
+		// The points are normalized, so the pivot is tiny only if they are degenerate.
+		if abs64(m[col][col]) < 1e-9*float64(len(raw)) {
+			return nil
+		}
+		for row := col + 1; row < n; row++ {
+			f := m[row][col] / m[col][col]
+			for j := col; j <= n; j++ {
+				m[row][j] -= f * m[col][j]
+			}
+		}
+	}
+	coeffs := make([]float64, n)
+	for i := n - 1; i >= 0; i-- {
+		s := m[i][n]
+		for j := i + 1; j < n; j++ {
+			s -= m[i][j] * coeffs[j]
+		}
+		coeffs[i] = s / m[i][i]
+	}
+
+	// Undo the scaling: c*(x/scale)^i = (c/scale^i)*x^i.
+	p := make(Polynomial, n)
+	s := 1.0
+	for i, c := range coeffs {
+		p[i] = float32(c / s)
+		s *= scale
+	}
+	return p
+}
+
+func abs64(x float64) float64 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
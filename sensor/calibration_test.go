@@ -0,0 +1,68 @@
+package sensor_test
+
+import (
+	"testing"
+
+	"github.com/orsinium-labs/tinymath/sensor"
+)
+
+func TestTwoPoint(t *testing.T) {
+	t.Parallel()
+	// A thermometer that reads 0.5 at 0°C and 99 at 100°C.
+	c := sensor.FitTwoPoint(0.5, 0, 99, 100)
+	close(t, float64(c.Apply(0.5)), 0, 1e-6)
+	close(t, float64(c.Apply(99)), 100, 1e-5)
+	close(t, float64(c.Apply(49.75)), 50, 1e-5)
+	close(t, float64(c.Gain), 100/98.5, 1e-6)
+}
+
+func TestFitPolynomial(t *testing.T) {
+	t.Parallel()
+	// A quadratic response of a 12-bit ADC.
+	exp := func(x float64) float64 { return -3.5 + 0.02*x + 1.5e-6*x*x }
+	var raw, ref []float32
+	for x := 0; x <= 4095; x += 455 {
+		raw = append(raw, float32(x))
+		ref = append(ref, float32(exp(float64(x))))
+	}
+	p := sensor.FitPolynomial(raw, ref, 2)
+	if len(p) != 3 {
+		t.Fatalf("len: %d", len(p))
+	}
+	close(t, float64(p[0]), -3.5, 1e-4)
+	close(t, float64(p[1]), 0.02, 1e-7)
+	close(t, float64(p[2]), 1.5e-6, 1e-10)
+	for x := 0.0; x <= 4095; x += 7 {
+		close(t, float64(p.Apply(float32(x))), exp(x), 1e-4)
+	}
+
+	// A linear fit through noisy points.
+	p = sensor.FitPolynomial([]float32{0, 1, 2, 3}, []float32{0.1, 0.9, 2.1, 2.9}, 1)
+	close(t, float64(p[0]), 0.06, 1e-6)
+	close(t, float64(p[1]), 0.96, 1e-6)
+
+	// A polynomial of degree 0 is the mean.
+	p = sensor.FitPolynomial([]float32{1, 2, 3}, []float32{4, 5, 9}, 0)
+	close(t, float64(p.Apply(100)), 6, 1e-6)
+}
+
+func TestFitPolynomialInvalid(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		raw, ref []float32
+		degree   int
+	}{
+		{[]float32{1, 2}, []float32{1, 2}, 2},
+		{[]float32{1, 2, 3}, []float32{1, 2}, 1},
+		{[]float32{1, 2}, []float32{1, 2}, -1},
+		{[]float32{2, 2, 2}, []float32{1, 2, 3}, 1},
+		{nil, nil, 0},
+	}
+	for _, c := range cases {
+		if p := sensor.FitPolynomial(c.raw, c.ref, c.degree); p != nil {
+			t.Fatalf("%v %v %d: %v", c.raw, c.ref, c.degree, p)
+		}
+	}
+	var empty sensor.Polynomial
+	close(t, float64(empty.Apply(1)), 0, 0)
+}
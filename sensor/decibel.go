@@ -0,0 +1,26 @@
+package sensor
+
+import "github.com/orsinium-labs/tinymath/audio"
+
+// Conversions into decibels have an absolute error below 1e-4 dB
+// and conversions from decibels have a relative error below 3e-6.
+
+// Converts the ratio of two powers (like watts) into decibels.
+func PowerToDecibels(ratio float32) float32 {
+	return audio.PowerToDecibels(ratio)
+}
+
+// Converts the ratio of two amplitudes (like volts or pascals) into decibels.
+func AmplitudeToDecibels(ratio float32) float32 {
+	return audio.GainToDecibels(ratio)
+}
+
+// Converts decibels into the ratio of two powers.
+func DecibelsToPower(db float32) float32 {
+	return audio.DecibelsToPower(db)
+}
+
+// Converts decibels into the ratio of two amplitudes.
+func DecibelsToAmplitude(db float32) float32 {
+	return audio.DecibelsToGain(db)
+}
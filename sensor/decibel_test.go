@@ -0,0 +1,22 @@
+package sensor_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/orsinium-labs/tinymath/sensor"
+)
+
+func TestDecibels(t *testing.T) {
+	t.Parallel()
+	for db := -120.0; db <= 120; db += 0.25 {
+		amp := math.Pow(10, db/20)
+		pow := math.Pow(10, db/10)
+		close(t, float64(sensor.DecibelsToAmplitude(float32(db))), amp, amp*3e-6)
+		close(t, float64(sensor.AmplitudeToDecibels(float32(amp))), db, 1e-4)
+		close(t, float64(sensor.DecibelsToPower(float32(db))), pow, pow*3e-6)
+		close(t, float64(sensor.PowerToDecibels(float32(pow))), db, 1e-4)
+	}
+	close(t, float64(sensor.AmplitudeToDecibels(1)), 0, 0)
+	close(t, float64(sensor.DecibelsToAmplitude(0)), 1, 0)
+}
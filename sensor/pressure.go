@@ -0,0 +1,37 @@
+package sensor
+
+// The standard atmospheric pressure at sea level in pascals.
+const StandardPressure float32 = 101325
+
+// Constants of the International Standard Atmosphere for the troposphere.
+const (
+	// The sea level temperature (288.15 K) divided by the temperature lapse rate (0.0065 K/m).
+	isaHeight = 44330.77
+	// g*M/(R*L), the exponent of the barometric formula.
+	isaExponent = 5.255877
+)
+
+// Converts the atmospheric pressure into the altitude in meters
+// using the barometric formula.
+//
+// The `seaLevel` is the pressure at sea level in the same units as `pressure`,
+// use [StandardPressure] if the local one is unknown. The formula is valid up to 11 km.
+// The error on top of the model is below 1 cm.
+func Altitude(pressure, seaLevel float32) float32 {
+	return isaHeight * (1 - pow(pressure/seaLevel, 1/isaExponent))
+}
+
+// Converts the altitude in meters into the atmospheric pressure,
+// the inverse of [Altitude].
+//
+// The result is in the same units as `seaLevel`. The relative error is below 1e-6.
+func Pressure(altitude, seaLevel float32) float32 {
+	return seaLevel * pow(1-altitude/isaHeight, isaExponent)
+}
+
+// Calculates the sea level pressure from the pressure measured at a known altitude.
+//
+// Use it to calibrate a barometer, and then pass the result into [Altitude].
+func SeaLevelPressure(pressure, altitude float32) float32 {
+	return pressure / pow(1-altitude/isaHeight, isaExponent)
+}
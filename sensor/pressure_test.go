@@ -0,0 +1,23 @@
+package sensor_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/orsinium-labs/tinymath/sensor"
+)
+
+func TestAltitude(t *testing.T) {
+	t.Parallel()
+	for _, p0 := range []float64{98_000, 101_325, 103_000} {
+		for h := -500.0; h <= 11_000; h += 10 {
+			p := p0 * math.Pow(1-h/44330.77, 5.255877)
+			close(t, float64(sensor.Altitude(float32(p), float32(p0))), h, 0.01)
+			close(t, float64(sensor.Pressure(float32(h), float32(p0))), p, p*1e-6)
+			close(t, float64(sensor.SeaLevelPressure(float32(p), float32(h))), p0, p0*1e-6)
+		}
+	}
+	close(t, float64(sensor.Altitude(sensor.StandardPressure, sensor.StandardPressure)), 0, 0)
+	// The standard atmosphere: 89 874.6 Pa at 1000 m.
+	close(t, float64(sensor.Altitude(89_874.6, sensor.StandardPressure)), 1000, 1)
+}
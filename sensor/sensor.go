@@ -0,0 +1,76 @@
+// Package sensor converts raw sensor readings into physical units.
+//
+// It covers ADC counts and voltage dividers, NTC thermistors (Steinhart-Hart
+// and the Beta equation), platinum RTDs, barometric altitude, decibels,
+// and two-point or polynomial calibration.
+//
+// Temperatures are in degrees Celsius and resistances are in ohms.
+// The accuracy of each conversion is documented on the function
+// and measured against float64 references in tests.
+package sensor
+
+import "github.com/orsinium-labs/tinymath"
+
+// 0°C in kelvins.
+const ZeroCelsius float32 = 273.15
+
+// Analog-to-digital converter.
+type ADC struct {
+	// The resolution in bits, from 1 to 31.
+	Bits uint8
+	// The reference voltage, the voltage that corresponds to the max reading.
+	VRef float32
+}
+
+// Returns the max raw reading, `2^Bits - 1`.
+func (a ADC) Max() uint32 {
+	return 1<<a.Bits - 1
+}
+
+// Converts a raw reading into the fraction of the reference voltage in `[0, 1]`.
+//
+// Readings above [ADC.Max] give values above 1.
+func (a ADC) Ratio(raw uint32) float32 {
+	return float32(raw) / float32(a.Max())
+}
+
+// Converts a raw reading into volts.
+func (a ADC) Volts(raw uint32) float32 {
+	return a.Ratio(raw) * a.VRef
+}
+
+// Converts volts into the closest raw reading, clamped to `[0, Max]`.
+func (a ADC) Raw(volts float32) uint32 {
+	m := a.Max()
+	r := tinymath.Round(volts / a.VRef * float32(m))
+	if !(r > 0) {
+		return 0
+	}
+	if r >= float32(m) {
+		return m
+	}
+	return uint32(r)
+}
+
+// Computes the sensor resistance in a voltage divider where the sensor
+// is connected to the ground and the `fixed` resistor is connected to the reference voltage.
+//
+// The `ratio` is the output voltage divided by the reference voltage, see [ADC.Ratio].
+// Returns +Inf for the ratio of 1 (an open circuit).
+func DividerLow(ratio, fixed float32) float32 {
+	return fixed * ratio / (1 - ratio)
+}
+
+// Computes the sensor resistance in a voltage divider where the sensor
+// is connected to the reference voltage and the `fixed` resistor is connected to the ground.
+//
+// The `ratio` is the output voltage divided by the reference voltage, see [ADC.Ratio].
+// Returns +Inf for the ratio of 0 (an open circuit).
+func DividerHigh(ratio, fixed float32) float32 {
+	return fixed * (1 - ratio) / ratio
+}
+
+// Computes `x^y` for a positive `x`, skipping the special cases of [tinymath.PowF].
+func pow(x, y float32) float32 {
+	return tinymath.Exp2(y * tinymath.Log2(x))
+}
@@ -0,0 +1,61 @@
+package sensor_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/orsinium-labs/tinymath"
+	"github.com/orsinium-labs/tinymath/sensor"
+)
+
+// Checks that the absolute difference is within the given tolerance.
+func close(t *testing.T, act, exp, eps float64) {
+	t.Helper()
+	if !(math.Abs(act-exp) <= eps) {
+		t.Fatalf("%g != %g (diff %g > %g)", act, exp, act-exp, eps)
+	}
+}
+
+func TestADC(t *testing.T) {
+	t.Parallel()
+	adc := sensor.ADC{Bits: 12, VRef: 3.3}
+	if adc.Max() != 4095 {
+		t.Fatalf("max: %d", adc.Max())
+	}
+	close(t, float64(adc.Ratio(0)), 0, 0)
+	close(t, float64(adc.Ratio(4095)), 1, 0)
+	close(t, float64(adc.Volts(2048)), 3.3*2048/4095, 1e-6)
+	cases := []struct {
+		volts float32
+		raw   uint32
+	}{
+		{0, 0}, {-1, 0}, {3.3, 4095}, {5, 4095}, {1.65, 2048}, {0.001, 1},
+		{tinymath.NaN, 0},
+	}
+	for _, c := range cases {
+		if raw := adc.Raw(c.volts); raw != c.raw {
+			t.Fatalf("Raw(%g) = %d, want %d", c.volts, raw, c.raw)
+		}
+	}
+	for raw := uint32(0); raw <= adc.Max(); raw++ {
+		if got := adc.Raw(adc.Volts(raw)); got != raw {
+			t.Fatalf("Raw(Volts(%d)) = %d", raw, got)
+		}
+	}
+}
+
+func TestDivider(t *testing.T) {
+	t.Parallel()
+	const fixed = 10_000
+	for _, r := range []float64{100, 1_000, 10_000, 47_000, 1_000_000} {
+		low := r / (r + fixed)
+		close(t, float64(sensor.DividerLow(float32(low), fixed)), r, r*1e-6)
+		close(t, float64(sensor.DividerHigh(float32(1-low), fixed)), r, r*1e-6)
+	}
+	if r := sensor.DividerLow(1, fixed); r != tinymath.Inf {
+		t.Fatalf("open circuit: %g", r)
+	}
+	if r := sensor.DividerHigh(0, fixed); r != tinymath.Inf {
+		t.Fatalf("open circuit: %g", r)
+	}
+}
@@ -0,0 +1,79 @@
+package sensor_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/orsinium-labs/tinymath/sensor"
+)
+
+// Coefficients of a common 10 kΩ NTC thermistor (B=3950).
+var shRef = [3]float64{1.009249522e-3, 2.378405444e-4, 2.019202697e-7}
+
+func shTemperature(r float64) float64 {
+	l := math.Log(r)
+	return 1/(shRef[0]+shRef[1]*l+shRef[2]*l*l*l) - 273.15
+}
+
+func TestSteinhartHart(t *testing.T) {
+	t.Parallel()
+	sh := sensor.SteinhartHart{A: float32(shRef[0]), B: float32(shRef[1]), C: float32(shRef[2])}
+	for r := 100.0; r < 1e6; r *= 1.1 {
+		close(t, float64(sh.Temperature(float32(r))), shTemperature(r), 0.01)
+	}
+}
+
+func TestFitSteinhartHart(t *testing.T) {
+	t.Parallel()
+	// Resistances at -20, 25, and 100 °C for the reference coefficients.
+	var rs [3]float64
+	for i, temp := range []float64{-20, 25, 100} {
+		lo, hi := 10.0, 1e6
+		for j := 0; j < 100; j++ {
+			mid := math.Sqrt(lo * hi)
+			if shTemperature(mid) > temp {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+		rs[i] = lo
+	}
+	sh := sensor.FitSteinhartHart(float32(rs[0]), -20, float32(rs[1]), 25, float32(rs[2]), 100)
+	for r := rs[2]; r < rs[0]; r *= 1.1 {
+		close(t, float64(sh.Temperature(float32(r))), shTemperature(r), 0.05)
+	}
+}
+
+func TestBeta(t *testing.T) {
+	t.Parallel()
+	b := sensor.Beta{R0: 10_000, T0: 25, Beta: 3950}
+	for temp := -40.0; temp <= 125; temp += 0.5 {
+		r := 10_000 * math.Exp(3950*(1/(temp+273.15)-1/298.15))
+		close(t, float64(b.Resistance(float32(temp))), r, r*1e-5)
+		close(t, float64(b.Temperature(float32(r))), temp, 0.01)
+	}
+	close(t, float64(b.Temperature(10_000)), 25, 1e-4)
+}
+
+func TestRTD(t *testing.T) {
+	t.Parallel()
+	// Reference values from the IEC 60751 table for PT100.
+	cases := []struct{ temp, r float64 }{
+		{-200, 18.52}, {-100, 60.26}, {-50, 80.31}, {0, 100}, {25, 109.73},
+		{100, 138.51}, {200, 175.86}, {500, 280.98}, {850, 390.48},
+	}
+	for _, c := range cases {
+		close(t, float64(sensor.PT100.Resistance(float32(c.temp))), c.r, 0.01)
+		close(t, float64(sensor.PT1000.Resistance(float32(c.temp))), c.r*10, 0.1)
+	}
+	const a, b, c = 3.9083e-3, -5.775e-7, -4.183e-12
+	for temp := -200.0; temp <= 850; temp += 0.25 {
+		p := 1 + a*temp + b*temp*temp
+		if temp < 0 {
+			p += c * (temp - 100) * temp * temp * temp
+		}
+		close(t, float64(sensor.PT100.Temperature(float32(100*p))), temp, 0.01)
+		close(t, float64(sensor.PT1000.Temperature(float32(1000*p))), temp, 0.01)
+	}
+}
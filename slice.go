@@ -0,0 +1,88 @@
+package tinymath
+
+// Batch versions of math functions that operate on slices.
+//
+// Each function calls a kernel that either processes elements in unrolled
+// blocks of 4 (slice_lanes.go) or one by one (slice_scalar.go).
+// Both kernels give the same results bit-for-bit. Which one is used
+// is decided by the build tags in slice_unrolled.go and slice_tinygo.go.
+//
+// All functions process `min(len(dst), len(src))` elements.
+// It's safe for `dst` and `src` to be the same slice.
+
+// Computes [Sin] for each element of `src` and writes results into `dst`.
+func SinSlice(dst, src []float32) {
+	n := min(len(dst), len(src))
+	dst, src = dst[:n], src[:n]
+	sinKernel(dst, src)
+}
+
+// Computes [Cos] for each element of `src` and writes results into `dst`.
+func CosSlice(dst, src []float32) {
+	n := min(len(dst), len(src))
+	dst, src = dst[:n], src[:n]
+	cosKernel(dst, src)
+}
+
+// Computes [Exp] for each element of `src` and writes results into `dst`.
+func ExpSlice(dst, src []float32) {
+	n := min(len(dst), len(src))
+	dst, src = dst[:n], src[:n]
+	expKernel(dst, src)
+}
+
+// Computes [Sqrt] for each element of `src` and writes results into `dst`.
+func SqrtSlice(dst, src []float32) {
+	n := min(len(dst), len(src))
+	dst, src = dst[:n], src[:n]
+	sqrtKernel(dst, src)
+}
+
+// Computes [Floor] for each element of `src` and writes results into `dst`.
+func FloorSlice(dst, src []float32) {
+	n := min(len(dst), len(src))
+	dst, src = dst[:n], src[:n]
+	floorKernel(dst, src)
+}
+
+// Computes [Clamp] for each element of `src` and writes results into `dst`.
+func ClampSlice(dst, src []float32, lo, hi float32) {
+	n := min(len(dst), len(src))
+	dst, src = dst[:n], src[:n]
+	clampKernel(dst, src, lo, hi)
+}
+
+// Computes `a*x + y` for each pair of elements of `x` and `y`
+// and writes results into `dst`.
+//
+// Processes `min(len(dst), len(x), len(y))` elements.
+// Use it to apply a gain to a signal and mix it into another one.
+func ScaleAdd(dst []float32, a float32, x, y []float32) {
+	n := min(len(dst), len(x), len(y))
+	dst, x, y = dst[:n], x[:n], y[:n]
+	scaleAddKernel(dst, a, x, y)
+}
+
+// Computes the dot product of two vectors.
+//
+// If the slices have different length, the extra elements of the longer one are ignored.
+// Each of the 4 lanes has its own running sum, so the result may slightly differ
+// from summing up products one by one. See [DotCompensated] for a precise version.
+func Dot(a, b []float32) float32 {
+	n := min(len(a), len(b))
+	a, b = a[:n], b[:n]
+	var s0, s1, s2, s3 float32
+	i := 0
+	for ; i+4 <= n; i += 4 {
+		as := a[i : i+4 : i+4]
+		bs := b[i : i+4 : i+4]
+		s0 += as[0] * bs[0]
+		s1 += as[1] * bs[1]
+		s2 += as[2] * bs[2]
+		s3 += as[3] * bs[3]
+	}
+	for ; i < n; i++ {
+		s0 += a[i] * b[i]
+	}
+	return (s0 + s1) + (s2 + s3)
+}
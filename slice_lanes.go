@@ -0,0 +1,214 @@
+package tinymath
+
+// Unrolled kernels for slice functions, written as operations on [f32x4].
+//
+// This is plain Go: each lane operation is 4 scalar operations in a row.
+// Processing 4 values at once lets the compiler hoist constants and bounds
+// checks out of the loop and interleave independent computations.
+//
+// Each lane operation does exactly the same as the matching scalar function,
+// so results are the same bit-for-bit as the kernels in slice_scalar.go.
+
+// The number of float32 values in one block.
+const lanes = 4
+
+// A vector of 4 float32 values.
+type f32x4 [lanes]float32
+
+func load4(s []float32) f32x4 {
+	_ = s[3]
+	return f32x4{s[0], s[1], s[2], s[3]}
+}
+
+func splat4(x float32) f32x4 {
+	return f32x4{x, x, x, x}
+}
+
+func (v f32x4) store(d []float32) {
+	_ = d[3]
+	d[0] = v[0]
+	d[1] = v[1]
+	d[2] = v[2]
+	d[3] = v[3]
+}
+
+func (a f32x4) add(b f32x4) f32x4 {
+	return f32x4{a[0] + b[0], a[1] + b[1], a[2] + b[2], a[3] + b[3]}
+}
+
+func (a f32x4) sub(b f32x4) f32x4 {
+	return f32x4{a[0] - b[0], a[1] - b[1], a[2] - b[2], a[3] - b[3]}
+}
+
+func (a f32x4) mul(b f32x4) f32x4 {
+	return f32x4{a[0] * b[0], a[1] * b[1], a[2] * b[2], a[3] * b[3]}
+}
+
+func (a f32x4) abs() f32x4 {
+	return f32x4{Abs(a[0]), Abs(a[1]), Abs(a[2]), Abs(a[3])}
+}
+
+func (a f32x4) sqrt() f32x4 {
+	return f32x4{Sqrt(a[0]), Sqrt(a[1]), Sqrt(a[2]), Sqrt(a[3])}
+}
+
+func (a f32x4) floor() f32x4 {
+	return f32x4{Floor(a[0]), Floor(a[1]), Floor(a[2]), Floor(a[3])}
+}
+
+// A vector of 4 int32 values.
+type i32x4 [lanes]int32
+
+// Converts each lane to int32 truncating towards zero.
+// All values must be in the int32 range.
+func (a f32x4) truncInt() i32x4 {
+	return i32x4{int32(a[0]), int32(a[1]), int32(a[2]), int32(a[3])}
+}
+
+// Converts each lane to float32.
+func (a i32x4) float() f32x4 {
+	return f32x4{float32(a[0]), float32(a[1]), float32(a[2]), float32(a[3])}
+}
+
+// Bitwise AND of each lane with the mask.
+func (a i32x4) and(mask int32) i32x4 {
+	return i32x4{a[0] & mask, a[1] & mask, a[2] & mask, a[3] & mask}
+}
+
+// Pseudo-maximum, `b < a ? a : b` in each lane.
+func (a f32x4) pmax(b f32x4) f32x4 {
+	for i := range a {
+		if a[i] < b[i] {
+			a[i] = b[i]
+		}
+	}
+	return a
+}
+
+// Pseudo-minimum, `b < a ? b : a` in each lane.
+func (a f32x4) pmin(b f32x4) f32x4 {
+	for i := range a {
+		if b[i] < a[i] {
+			a[i] = b[i]
+		}
+	}
+	return a
+}
+
+// The same as [cosTurns] but for 4 values at once.
+func cosTurns4(x f32x4) f32x4 {
+	x = x.sub(splat4(0.25).add(x.add(splat4(0.25)).floor()))
+	x = x.mul(splat4(16.0).mul(x.abs().sub(splat4(0.5))))
+	x = x.add(splat4(0.225).mul(x).mul(x.abs().sub(splat4(1.0))))
+	return x
+}
+
+// Each kernel processes as many full blocks as possible
+// and passes the remaining tail to the scalar kernel.
+
+// The same as [reduceTurns] but for 4 values at once.
+//
+// Returns false if the values need different reduction methods
+// or any of them needs Payne-Hanek reduction. Such blocks are rare,
+// so they go through the scalar function.
+func reduceTurns4(x f32x4) (f32x4, bool) {
+	a := x.abs()
+	if a.within(-1, directMax) {
+		return x.mul(splat4(Frac1Pi / 2.0)), true
+	}
+	if !a.within(directMax, codyWaiteMax) {
+		return x, false
+	}
+	k := x.mul(splat4(Frac2Pi)).truncInt()
+	kf := k.float()
+	r := x.sub(kf.mul(splat4(pio2Hi))).sub(kf.mul(splat4(pio2Mid))).sub(kf.mul(splat4(pio2Lo)))
+	q := k.and(3).float().mul(splat4(0.25))
+	return r.mul(splat4(Frac1Pi / 2.0)).add(q), true
+}
+
+// Checks if all lanes are in `(lo, hi]`. Returns false if any of them is NaN.
+func (a f32x4) within(lo, hi float32) bool {
+	for _, x := range a {
+		if !(x > lo && x <= hi) {
+			return false
+		}
+	}
+	return true
+}
+
+func sinLanes(dst, src []float32) {
+	shift := splat4(0.25)
+	i := 0
+	for ; i+lanes <= len(src); i += lanes {
+		x := load4(src[i:])
+		t, ok := reduceTurns4(x)
+		if !ok {
+			f32x4{Sin(x[0]), Sin(x[1]), Sin(x[2]), Sin(x[3])}.store(dst[i:])
+			continue
+		}
+		cosTurns4(t.sub(shift)).store(dst[i:])
+	}
+	sinScalar(dst[i:], src[i:])
+}
+
+func cosLanes(dst, src []float32) {
+	i := 0
+	for ; i+lanes <= len(src); i += lanes {
+		x := load4(src[i:])
+		t, ok := reduceTurns4(x)
+		if !ok {
+			f32x4{Cos(x[0]), Cos(x[1]), Cos(x[2]), Cos(x[3])}.store(dst[i:])
+			continue
+		}
+		cosTurns4(t).store(dst[i:])
+	}
+	cosScalar(dst[i:], src[i:])
+}
+
+func expLanes(dst, src []float32) {
+	// Exp has too many branches to be written as lane operations,
+	// unrolling is the best we can do.
+	i := 0
+	for ; i+lanes <= len(src); i += lanes {
+		x := load4(src[i:])
+		f32x4{Exp(x[0]), Exp(x[1]), Exp(x[2]), Exp(x[3])}.store(dst[i:])
+	}
+	expScalar(dst[i:], src[i:])
+}
+
+func sqrtLanes(dst, src []float32) {
+	i := 0
+	for ; i+lanes <= len(src); i += lanes {
+		load4(src[i:]).sqrt().store(dst[i:])
+	}
+	sqrtScalar(dst[i:], src[i:])
+}
+
+func floorLanes(dst, src []float32) {
+	i := 0
+	for ; i+lanes <= len(src); i += lanes {
+		load4(src[i:]).floor().store(dst[i:])
+	}
+	floorScalar(dst[i:], src[i:])
+}
+
+func clampLanes(dst, src []float32, lo, hi float32) {
+	vlo := splat4(lo)
+	vhi := splat4(hi)
+	i := 0
+	for ; i+lanes <= len(src); i += lanes {
+		// pmax and pmin have the same semantic as the branches in Clamp,
+		// including for NaN.
+		load4(src[i:]).pmax(vlo).pmin(vhi).store(dst[i:])
+	}
+	clampScalar(dst[i:], src[i:], lo, hi)
+}
+
+func scaleAddLanes(dst []float32, a float32, x, y []float32) {
+	va := splat4(a)
+	i := 0
+	for ; i+lanes <= len(x); i += lanes {
+		va.mul(load4(x[i:])).add(load4(y[i:])).store(dst[i:])
+	}
+	scaleAddScalar(dst[i:], a, x[i:], y[i:])
+}
@@ -0,0 +1,48 @@
+package tinymath
+
+// Scalar kernels for slice functions that process elements one by one.
+//
+// They handle the tail after the unrolled kernels in slice_lanes.go
+// and serve as a reference for them in tests.
+
+func sinScalar(dst, src []float32) {
+	for i, x := range src {
+		dst[i] = Sin(x)
+	}
+}
+
+func cosScalar(dst, src []float32) {
+	for i, x := range src {
+		dst[i] = Cos(x)
+	}
+}
+
+func expScalar(dst, src []float32) {
+	for i, x := range src {
+		dst[i] = Exp(x)
+	}
+}
+
+func sqrtScalar(dst, src []float32) {
+	for i, x := range src {
+		dst[i] = Sqrt(x)
+	}
+}
+
+func floorScalar(dst, src []float32) {
+	for i, x := range src {
+		dst[i] = Floor(x)
+	}
+}
+
+func clampScalar(dst, src []float32, lo, hi float32) {
+	for i, x := range src {
+		dst[i] = Clamp(x, lo, hi)
+	}
+}
+
+func scaleAddScalar(dst []float32, a float32, x, y []float32) {
+	for i := range x {
+		dst[i] = a*x[i] + y[i]
+	}
+}
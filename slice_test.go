@@ -0,0 +1,257 @@
+package tinymath_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/orsinium-labs/tinymath"
+)
+
+// Returns n values spread over the `[-10, 10]` range.
+func sliceSamples(n int) []float32 {
+	xs := make([]float32, n)
+	for i := range xs {
+		xs[i] = float32(i)*20/float32(n+1) - 10
+	}
+	return xs
+}
+
+func TestSliceFuncs(t *testing.T) {
+	t.Parallel()
+	funcs := []struct {
+		name   string
+		slice  func(dst, src []float32)
+		scalar func(float32) float32
+	}{
+		{"sin", tinymath.SinSlice, tinymath.Sin},
+		{"cos", tinymath.CosSlice, tinymath.Cos},
+		{"exp", tinymath.ExpSlice, tinymath.Exp},
+		{"sqrt", tinymath.SqrtSlice, tinymath.Sqrt},
+		{"floor", tinymath.FloorSlice, tinymath.Floor},
+		{"clamp", func(dst, src []float32) {
+			tinymath.ClampSlice(dst, src, -2, 3)
+		}, func(x float32) float32 {
+			return tinymath.Clamp(x, -2, 3)
+		}},
+	}
+	for _, f := range funcs {
+		f := f
+		t.Run(f.name, func(t *testing.T) {
+			t.Parallel()
+			// Lengths around the block size to cover the tail handling.
+			for n := 0; n <= 13; n++ {
+				src := sliceSamples(n)
+				dst := make([]float32, n)
+				f.slice(dst, src)
+				for i, x := range src {
+					same(t, dst[i], f.scalar(x))
+				}
+				// In-place.
+				f.slice(src, src)
+				for i := range src {
+					same(t, src[i], dst[i])
+				}
+			}
+			// Special values must go through the block kernels
+			// the same way as through the scalar function.
+			src := []float32{
+				0, tinymath.NaN, tinymath.Inf, tinymath.NegInf,
+				0, 1e-40, -1e-40, 1e30,
+				-1e30, 0.5, -0.5, 2.5,
+			}
+			// Negative zero can't be written as a constant.
+			src[4] = tinymath.CopySign(0, -1)
+			dst := make([]float32, len(src))
+			f.slice(dst, src)
+			for i, x := range src {
+				same(t, dst[i], f.scalar(x))
+			}
+		})
+	}
+}
+
+// The unrolled and the scalar kernels must give the same results bit-for-bit,
+// whichever of them the slice functions use in this build.
+func TestSliceKernels(t *testing.T) {
+	t.Parallel()
+	kernels := []struct {
+		name   string
+		lanes  func(dst, src []float32)
+		scalar func(dst, src []float32)
+	}{
+		{"sin", tinymath.SinLanes, tinymath.SinScalar},
+		{"cos", tinymath.CosLanes, tinymath.CosScalar},
+		{"exp", tinymath.ExpLanes, tinymath.ExpScalar},
+		{"sqrt", tinymath.SqrtLanes, tinymath.SqrtScalar},
+		{"floor", tinymath.FloorLanes, tinymath.FloorScalar},
+		{"clamp", func(dst, src []float32) {
+			tinymath.ClampLanes(dst, src, -2, 3)
+		}, func(dst, src []float32) {
+			tinymath.ClampScalar(dst, src, -2, 3)
+		}},
+		{"scale-add", func(dst, src []float32) {
+			tinymath.ScaleAddLanes(dst, 0.7, src, src)
+		}, func(dst, src []float32) {
+			tinymath.ScaleAddScalar(dst, 0.7, src, src)
+		}},
+	}
+	specials := []float32{
+		0, tinymath.NaN, tinymath.Inf, tinymath.NegInf,
+		0, 1e-40, -1e-40, 1e30,
+		-1e30, 0.5, -0.5, 2.5, 1e5,
+	}
+	specials[4] = tinymath.CopySign(0, -1)
+	for _, k := range kernels {
+		k := k
+		t.Run(k.name, func(t *testing.T) {
+			t.Parallel()
+			for n := 0; n <= 13; n++ {
+				for _, src := range [][]float32{sliceSamples(n), specials[:n]} {
+					exp := make([]float32, n)
+					act := make([]float32, n)
+					k.scalar(exp, src)
+					k.lanes(act, src)
+					for i := range exp {
+						if tinymath.ToBits(act[i]) != tinymath.ToBits(exp[i]) {
+							t.Fatalf("n=%d, x=%f: %f != %f", n, src[i], act[i], exp[i])
+						}
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestSliceShortDst(t *testing.T) {
+	t.Parallel()
+	src := []float32{1, 4, 9, 16, 25, 36}
+	dst := make([]float32, 7)
+	dst[5] = 100
+	tinymath.SqrtSlice(dst[:5], src)
+	same(t, dst[4], tinymath.Sqrt(25))
+	eq(t, dst[5], 100)
+	tinymath.SqrtSlice(dst, src[:2])
+	eq(t, dst[2], tinymath.Sqrt(9))
+}
+
+func TestScaleAdd(t *testing.T) {
+	t.Parallel()
+	for n := 0; n <= 13; n++ {
+		x := sliceSamples(n)
+		y := sliceSamples(n + 1)
+		dst := make([]float32, n+2)
+		tinymath.ScaleAdd(dst, 0.5, x, y)
+		for i := 0; i < n; i++ {
+			eq(t, dst[i], 0.5*x[i]+y[i])
+		}
+		eq(t, dst[n], 0)
+	}
+}
+
+func TestDot(t *testing.T) {
+	t.Parallel()
+	eq(t, tinymath.Dot(nil, nil), 0)
+	eq(t, tinymath.Dot([]float32{1, 2, 3}, []float32{4, 5, 6, 7}), 32)
+	for n := 0; n <= 13; n++ {
+		t.Run(fmt.Sprintf("%d", n), func(t *testing.T) {
+			a := sliceSamples(n)
+			b := sliceSamples(n)
+			var exp float64
+			for i := range a {
+				exp += float64(a[i]) * float64(b[i])
+			}
+			act := tinymath.Dot(a, b)
+			if math.Abs(float64(act)-exp) > 1e-5*exp {
+				t.Fatalf("%f != %f", act, exp)
+			}
+		})
+	}
+}
+
+const benchSize = 1024
+
+func benchSlice(b *testing.B, slice func(dst, src []float32)) {
+	src := sliceSamples(benchSize)
+	dst := make([]float32, benchSize)
+	b.SetBytes(benchSize * 4)
+	for i := 0; i < b.N; i++ {
+		slice(dst, src)
+	}
+}
+
+func benchLoop(b *testing.B, scalar func(float32) float32) {
+	src := sliceSamples(benchSize)
+	dst := make([]float32, benchSize)
+	b.SetBytes(benchSize * 4)
+	for i := 0; i < b.N; i++ {
+		for j, x := range src {
+			dst[j] = scalar(x)
+		}
+	}
+}
+
+func BenchmarkSinSlice(b *testing.B)  { benchSlice(b, tinymath.SinSlice) }
+func BenchmarkSinLoop(b *testing.B)   { benchLoop(b, tinymath.Sin) }
+func BenchmarkCosSlice(b *testing.B)  { benchSlice(b, tinymath.CosSlice) }
+func BenchmarkCosLoop(b *testing.B)   { benchLoop(b, tinymath.Cos) }
+func BenchmarkExpSlice(b *testing.B)  { benchSlice(b, tinymath.ExpSlice) }
+func BenchmarkExpLoop(b *testing.B)   { benchLoop(b, tinymath.Exp) }
+func BenchmarkSqrtSlice(b *testing.B) { benchSlice(b, tinymath.SqrtSlice) }
+func BenchmarkSqrtLoop(b *testing.B)  { benchLoop(b, tinymath.Sqrt) }
+
+func BenchmarkFloorSlice(b *testing.B) { benchSlice(b, tinymath.FloorSlice) }
+func BenchmarkFloorLoop(b *testing.B)  { benchLoop(b, tinymath.Floor) }
+
+func BenchmarkClampSlice(b *testing.B) {
+	benchSlice(b, func(dst, src []float32) { tinymath.ClampSlice(dst, src, -2, 3) })
+}
+func BenchmarkClampLoop(b *testing.B) {
+	benchLoop(b, func(x float32) float32 { return tinymath.Clamp(x, -2, 3) })
+}
+
+func BenchmarkScaleAdd(b *testing.B) {
+	x := sliceSamples(benchSize)
+	y := sliceSamples(benchSize)
+	dst := make([]float32, benchSize)
+	b.SetBytes(benchSize * 4)
+	for i := 0; i < b.N; i++ {
+		tinymath.ScaleAdd(dst, 0.5, x, y)
+	}
+}
+
+func BenchmarkScaleAddLoop(b *testing.B) {
+	x := sliceSamples(benchSize)
+	y := sliceSamples(benchSize)
+	dst := make([]float32, benchSize)
+	b.SetBytes(benchSize * 4)
+	for i := 0; i < b.N; i++ {
+		for j := range dst {
+			dst[j] = 0.5*x[j] + y[j]
+		}
+	}
+}
+
+var dotSink float32
+
+func BenchmarkDot(b *testing.B) {
+	x := sliceSamples(benchSize)
+	y := sliceSamples(benchSize)
+	b.SetBytes(benchSize * 4)
+	for i := 0; i < b.N; i++ {
+		dotSink = tinymath.Dot(x, y)
+	}
+}
+
+func BenchmarkDotLoop(b *testing.B) {
+	x := sliceSamples(benchSize)
+	y := sliceSamples(benchSize)
+	b.SetBytes(benchSize * 4)
+	for i := 0; i < b.N; i++ {
+		var s float32
+		for j := range x {
+			s += x[j] * y[j]
+		}
+		dotSink = s
+	}
+}
@@ -0,0 +1,15 @@
+//go:build tinygo.wasm && !unrolled
+
+package tinymath
+
+// On wasm, unrolled kernels make the binary bigger. So they are disabled
+// unless the `unrolled` tag is specified, and slice functions use
+// the scalar kernels from slice_scalar.go.
+
+func sinKernel(dst, src []float32)                            { sinScalar(dst, src) }
+func cosKernel(dst, src []float32)                            { cosScalar(dst, src) }
+func expKernel(dst, src []float32)                            { expScalar(dst, src) }
+func sqrtKernel(dst, src []float32)                           { sqrtScalar(dst, src) }
+func floorKernel(dst, src []float32)                          { floorScalar(dst, src) }
+func clampKernel(dst, src []float32, lo, hi float32)          { clampScalar(dst, src, lo, hi) }
+func scaleAddKernel(dst []float32, a float32, x, y []float32) { scaleAddScalar(dst, a, x, y) }
@@ -0,0 +1,15 @@
+//go:build !tinygo.wasm || unrolled
+
+package tinymath
+
+// Slice functions use the unrolled kernels from slice_lanes.go.
+//
+// On wasm, they are used only with the `unrolled` build tag, see slice_tinygo.go.
+
+func sinKernel(dst, src []float32)                            { sinLanes(dst, src) }
+func cosKernel(dst, src []float32)                            { cosLanes(dst, src) }
+func expKernel(dst, src []float32)                            { expLanes(dst, src) }
+func sqrtKernel(dst, src []float32)                           { sqrtLanes(dst, src) }
+func floorKernel(dst, src []float32)                          { floorLanes(dst, src) }
+func clampKernel(dst, src []float32, lo, hi float32)          { clampLanes(dst, src, lo, hi) }
+func scaleAddKernel(dst []float32, a float32, x, y []float32) { scaleAddLanes(dst, a, x, y) }
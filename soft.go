@@ -1,27 +1,28 @@
-//go:build !tinygo.wasm
-
 package tinymath
 
-// Functions that can be optimized for wasm
+// Software implementations of functions that some architectures
+// have a hardware instruction for. The arch_*.go files pick
+// either the instruction or the function from here.
 
-// Returns the smallest integer greater than or equal to a number.
-func Ceil(self float32) float32 {
-	return -Floor(-self)
+func ceilSoft(self float32) float32 {
+	return -floorSoft(-self)
 }
 
-// Returns the largest integer less than or equal to a number.
-func Floor(self float32) float32 {
+func floorSoft(self float32) float32 {
+	// Starting from 2^23, all floats are integers.
+	// The negated condition also catches NaN and infinities.
+	if !(Abs(self) < 1<<mantissaBits) {
+		return self
+	}
 	res := float32(int32(self))
 	if self < res {
 		res -= 1.0
 	}
-	return float32(res)
+	// Keep the sign of zero, like in `Floor(-0.0) == -0.0`.
+	return CopySign(res, self)
 }
 
-// Approximates the square root of a number with an average deviation of ~5%.
-//
-// Returns [`NAN`] if `self` is a negative number.
-func Sqrt(self float32) float32 {
+func sqrtSoft(self float32) float32 {
 	if self >= 0.0 {
 		return FromBits((ToBits(self) + 0x3f80_0000) >> 1)
 	} else {
@@ -29,8 +30,7 @@ func Sqrt(self float32) float32 {
 	}
 }
 
-// Returns the integer part of a number.
-func Trunc(self float32) float32 {
+func truncSoft(self float32) float32 {
 	const MANTISSA_MASK = 0b0000_0000_0111_1111_1111_1111_1111_1111
 
 	x_bits := ToBits(self)
@@ -55,7 +55,7 @@ func Trunc(self float32) float32 {
 	return FromBits(x_bits & ^fractional_mask)
 }
 
-func leadingZeros(x uint32) uint32 {
+func leadingZerosSoft(x uint32) uint32 {
 	var n uint32 = 32
 	for x != 0 {
 		x >>= 1
@@ -0,0 +1,138 @@
+package tinymath
+
+// Compensated summation algorithms.
+//
+// Adding many float32 numbers one by one accumulates a rounding error
+// that grows linearly with the number of elements. The functions here
+// keep the error (almost) independent of the number of elements
+// without switching to float64.
+
+// A running sum that compensates for the rounding errors.
+//
+// Use it to accumulate a stream of values, like sensor readings,
+// when it's not possible to keep them all in a slice.
+// It uses the Neumaier's improvement of the Kahan summation algorithm,
+// so it stays precise even if the next value is bigger than the running sum.
+// The zero value is an empty sum.
+type Accumulator struct {
+	sum float32
+	c   float32
+}
+
+// Add the value to the sum.
+func (a *Accumulator) Add(x float32) {
+	t := a.sum + x
+	if Abs(a.sum) >= Abs(x) {
+		a.c += (a.sum - t) + x
+	} else {
+		a.c += (x - t) + a.sum
+	}
+	a.sum = t
+}
+
+// The current value of the sum.
+func (a *Accumulator) Sum() float32 {
+	return a.sum + a.c
+}
+
+// Forget all added values.
+func (a *Accumulator) Reset() {
+	a.sum = 0
+	a.c = 0
+}
+
+// Computes the sum of all values using the Kahan summation algorithm.
+//
+// The error bound is `2ε·Σ|x|` regardless of the number of values,
+// as long as each next value is smaller than the running sum.
+func KahanSum(xs []float32) float32 {
+	var sum, c float32
+	for _, x := range xs {
+		y := x - c
+		t := sum + y
+		c = (t - sum) - y
+		sum = t
+	}
+	return sum
+}
+
+// Computes the sum of all values using the Kahan-Babuška-Neumaier algorithm.
+//
+// It's a bit slower than [KahanSum] but it's also precise when values
+// have a very different magnitude, like `[1, 1e10, 1, -1e10]`.
+func NeumaierSum(xs []float32) float32 {
+	var a Accumulator
+	for _, x := range xs {
+		a.Add(x)
+	}
+	return a.Sum()
+}
+
+// Computes the sum of all values by recursively summing up halves.
+//
+// The error grows only logarithmically with the number of values.
+// It's faster than [KahanSum] but less precise.
+func PairwiseSum(xs []float32) float32 {
+	// Small blocks are summed up directly to not waste time on recursion.
+	const block = 8
+	if len(xs) <= block {
+		var sum float32
+		for _, x := range xs {
+			sum += x
+		}
+		return sum
+	}
+	mid := len(xs) / 2
+	return PairwiseSum(xs[:mid]) + PairwiseSum(xs[mid:])
+}
+
+// Computes the dot product of two vectors with the compensated algorithm Dot2
+// by Ogita, Rump, and Oishi.
+//
+// The result is as precise as if it was computed in the doubled precision
+// and then rounded to float32.
+// If the slices have different length, the extra elements of the longer one are ignored.
+// Elements must be smaller than about 8e34 in magnitude, otherwise intermediate results overflow.
+func DotCompensated(a, b []float32) float32 {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	var sum, c float32
+	for i := 0; i < n; i++ {
+		p, pe := twoProduct(a[i], b[i])
+		s, se := twoSum(sum, p)
+		sum = s
+		c += pe + se
+	}
+	return sum + c
+}
+
+// Returns the sum and its rounding error, so that `a + b == s + e` exactly.
+func twoSum(a, b float32) (float32, float32) {
+	s := a + b
+	bb := s - a
+	e := (a - (s - bb)) + (b - bb)
+	return s, e
+}
+
+// Returns the product and its rounding error, so that `a * b == p + e` exactly.
+//
+// It uses the Dekker's algorithm which doesn't need FMA.
+// The explicit conversions prevent the compiler from fusing
+// multiplications and additions, which would break the algorithm.
+func twoProduct(a, b float32) (float32, float32) {
+	p := float32(a * b)
+	ah, al := split(a)
+	bh, bl := split(b)
+	e := float32(float32(float32(ah*bh)-p)+float32(ah*bl)+float32(al*bh)) + float32(al*bl)
+	return p, e
+}
+
+// Splits the number into two halves with 12 significant bits each (Veltkamp split).
+func split(a float32) (float32, float32) {
+	const factor = 1<<12 + 1
+	c := float32(factor * a)
+	h := c - (c - a)
+	return h, a - h
+}
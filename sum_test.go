@@ -0,0 +1,116 @@
+package tinymath_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/orsinium-labs/tinymath"
+)
+
+// Returns the sum calculated in float64.
+func sum64(xs []float32) float64 {
+	var s float64
+	for _, x := range xs {
+		s += float64(x)
+	}
+	return s
+}
+
+func naiveSum(xs []float32) float32 {
+	var s float32
+	for _, x := range xs {
+		s += x
+	}
+	return s
+}
+
+func relErr(act float32, exp float64) float64 {
+	return math.Abs(float64(act)-exp) / math.Abs(exp)
+}
+
+func TestSumPrecision(t *testing.T) {
+	t.Parallel()
+	// A million of sensor readings around 0.1.
+	rng := rand.New(rand.NewSource(1))
+	xs := make([]float32, 1_000_000)
+	for i := range xs {
+		xs[i] = 0.1 + float32(rng.Float64()*0.01)
+	}
+	exp := sum64(xs)
+
+	naive := relErr(naiveSum(xs), exp)
+	if naive < 1e-4 {
+		t.Fatalf("the naive sum is too precise to compare: %g", naive)
+	}
+	if e := relErr(tinymath.KahanSum(xs), exp); e > 1e-7 {
+		t.Fatalf("kahan: %g", e)
+	}
+	if e := relErr(tinymath.NeumaierSum(xs), exp); e > 1e-7 {
+		t.Fatalf("neumaier: %g", e)
+	}
+	if e := relErr(tinymath.PairwiseSum(xs), exp); e > naive/100 {
+		t.Fatalf("pairwise: %g, naive: %g", e, naive)
+	}
+
+	var acc tinymath.Accumulator
+	for _, x := range xs {
+		acc.Add(x)
+	}
+	if e := relErr(acc.Sum(), exp); e > 1e-7 {
+		t.Fatalf("accumulator: %g", e)
+	}
+	acc.Reset()
+	eq(t, acc.Sum(), 0)
+}
+
+func TestNeumaierSum(t *testing.T) {
+	t.Parallel()
+	xs := []float32{1, 1e10, 1, -1e10}
+	eq(t, naiveSum(xs), 0)
+	eq(t, tinymath.KahanSum(xs), 0)
+	eq(t, tinymath.NeumaierSum(xs), 2)
+}
+
+func TestPairwiseSum(t *testing.T) {
+	t.Parallel()
+	eq(t, tinymath.PairwiseSum(nil), 0)
+	eq(t, tinymath.PairwiseSum([]float32{3}), 3)
+	xs := make([]float32, 1001)
+	for i := range xs {
+		xs[i] = float32(i)
+	}
+	eq(t, tinymath.PairwiseSum(xs), 500500)
+}
+
+func TestDotCompensated(t *testing.T) {
+	t.Parallel()
+	// An ill-conditioned dot product where the naive result is zero.
+	a := []float32{1e8, 1, -1e8, 1 + 0x1p-12}
+	b := []float32{1e8, 1, 1e8, 1 + 0x1p-12}
+	var naive float32
+	for i := range a {
+		naive += a[i] * b[i]
+	}
+	var exp float64
+	for i := range a {
+		exp += float64(a[i]) * float64(b[i])
+	}
+	act := tinymath.DotCompensated(a, b)
+	if float64(act) != float64(float32(exp)) {
+		t.Fatalf("%g != %g (naive: %g)", act, exp, naive)
+	}
+
+	rng := rand.New(rand.NewSource(2))
+	a = make([]float32, 10_000)
+	b = make([]float32, 10_001)
+	exp = 0
+	for i := range a {
+		a[i] = float32(rng.NormFloat64() * 1000)
+		b[i] = float32(rng.NormFloat64())
+		exp += float64(a[i]) * float64(b[i])
+	}
+	if e := relErr(tinymath.DotCompensated(a, b), exp); e > 1e-7 {
+		t.Fatalf("random: %g", e)
+	}
+}
@@ -53,8 +53,42 @@ func DivEuclid(self float32, rhs float32) float32 {
 }
 
 // Returns `e^(self)`, (the exponential function).
+//
+// It's [Exp2] of `self*Log2E`. Rounding of the product adds to the error of [Exp2],
+// so the relative error is below 6e-6.
 func Exp(self float32) float32 {
-	return ExpLn2Approx(self, 4)
+	return Exp2(self * Log2E)
+}
+
+// Approximates `2^self`.
+//
+// The relative error is below 3e-7 and the result is exact for integers.
+// It's faster and more precise than `PowF(2, self)`.
+func Exp2(self float32) float32 {
+	switch {
+	case self != self:
+		return NaN
+	case self >= 128:
+		return Inf
+	case self < -150:
+		return 0
+	}
+	// self = k + f, where k is an integer and f is in [0, 1).
+	k := int32(self)
+	if float32(k) > self {
+		k--
+	}
+	// Minimax approximation of 2^f on [0, 1]:
+	//
+	//	go run ./cmd/tinymath-remez -func exp2 -from 0 -to 1 -degree 5 -metric rel
+	//
+	// The first coefficient is rounded from 0.999_999_94 to 1, so that integer powers are exact.
+	f := self - float32(k)
+	p := 1 + f*(0.693_153_1+f*(0.240_153_61+f*(0.055_826_318+f*(0.008_989_34+f*0.001_877_576_7))))
+	if k < 1-expBias {
+		return Ldexp(p, k)
+	}
+	return FromBits(ToBits(p) + uint32(k)<<mantissaBits)
 }
 
 // Exp approximation for `f32`.
@@ -81,7 +115,7 @@ func ExpLn2Approx(self float32, partial_iter uint32) float32 {
 	fract_exp := ExpSmallX(x_fract, partial_iter)
 
 	//need the 2^n portion, we can just extract that from the whole number exp portion
-	fract_exponent := saturatingAdd(extractExponentValue(fract_exp), int32(x_trunc))
+	fract_exponent := SaturatingAdd(extractExponentValue(fract_exp), ToInt32Sat(x_trunc))
 
 	if fract_exponent < -expBias {
 		return 0.0
@@ -178,43 +212,10 @@ func IsSignPositive(x float32) bool {
 }
 
 // Approximates the natural logarithm of the number.
-// Note: excessive precision ignored because it hides the origin of the numbers used for the
-// ln(1.0->2.0) polynomial
+//
+// It's [Log2] scaled by ln(2), so the error and special cases are the same.
 func Ln(self float32) float32 {
-	// x may essentially be 1.0 but, as clippy notes, these kinds of
-	// floating point comparisons can fail when the bit pattern is not the sames
-	if Abs(self-1) < Epsilon {
-		return 0.0
-	}
-
-	x_less_than_1 := self < 1.0
-
-	// Note: we could use the fast inverse approximation here found in super::inv::inv_approx, but
-	// the precision of such an approximation is assumed not good enough.
-	x_working := self
-	if x_less_than_1 {
-		x_working = Inv(self)
-	}
-
-	// according to the SO post ln(x) = ln((2^n)*y)= ln(2^n) + ln(y) = ln(2) * n + ln(y)
-	// get exponent value
-	base2_exponent := uint32(extractExponentValue(x_working))
-	divisor := FromBits(ToBits(x_working) & expMask)
-
-	// supposedly normalizing between 1.0 and 2.0
-	x_working = x_working / divisor
-
-	// approximate polynomial generated from maple in the post using Remez Algorithm:
-	// https://en.wikipedia.org/wiki/Remez_algorithm
-	ln_1to2_polynomial := -1.741_793_9 + (2.821_202_6+(-1.469_956_8+(0.447_179_55-0.056_570_851*x_working)*x_working)*x_working)*x_working
-
-	// ln(2) * n + ln(y)
-	result := float32(base2_exponent)*Ln2 + ln_1to2_polynomial
-
-	if x_less_than_1 {
-		return -result
-	}
-	return result
+	return Log2(self) * Ln2
 }
 
 // Approximates the logarithm of the number with respect to an arbitrary base.
@@ -223,32 +224,119 @@ func Log(self float32, base float32) float32 {
 }
 
 // Approximates the base 10 logarithm of the number.
+//
+// It's [Log2] scaled by log10(2), so the error and special cases are the same.
 func Log10(self float32) float32 {
-	return Ln(self) * Log10E
+	return Log2(self) * 0.301_029_995_663_981_2
 }
 
 // Approximates the base 2 logarithm of the number.
+//
+// The relative error is below 3e-7 and the result is exact for powers of two.
+// Returns NaN for negative numbers and -Inf for zero.
 func Log2(self float32) float32 {
-	return Ln(self) * Log2E
+	switch {
+	case self != self || self < 0:
+		return NaN
+	case self == 0:
+		return NegInf
+	case self == Inf:
+		return Inf
+	}
+	bits := ToBits(self)
+	e := int32(bits>>mantissaBits) - expBias
+	if bits>>mantissaBits == 0 {
+		// Subnormal, make it normal.
+		bits = ToBits(self * (1 << mantissaBits))
+		e = int32(bits>>mantissaBits) - expBias - mantissaBits
+	}
+	// self = 2^e * m, where m is in [sqrt(2)/2, sqrt(2)).
+	m := FromBits(bits&^expMask | expBias<<mantissaBits)
+	if m > Sqrt2 {
+		m *= 0.5
+		e++
+	}
+	// log2(m) = 2/ln(2) * atanh(s), where s = (m-1)/(m+1) is in [-0.18, 0.18].
+	// The coefficients are 2/ln(2) divided by 1, 3, 5, and 7: the terms of the atanh series.
+	s := (m - 1) / (m + 1)
+	s2 := s * s
+	return float32(e) + s*(2.885_390_1+s2*(0.961_796_7+s2*(0.577_078_03+s2*0.412_198_58)))
 }
 
 // Approximates a number raised to a floating point power.
+//
+// Integer powers below 2³¹ are delegated to [PowI], so their error grows with `|n|`.
+// Other powers are computed as `Exp(n*Ln(x))`. Special cases are the same as for `math.Pow`:
+//
+//   - `PowF(x, ±0) = 1` and `PowF(1, y) = 1` for any `x` and `y`, even NaN.
+//   - `PowF(x, y) = NaN` if `x` or `y` is NaN, or if `x` is negative
+//     and `y` is a finite non-integer.
+//   - `PowF(±0, y)` is `±0` or `±Inf` (for `y < 0`). The sign is kept
+//     only if `y` is an odd integer.
+//   - `PowF(-1, ±Inf) = 1`. For other `x`, `PowF(x, ±Inf)` is `+0` or `+Inf`
+//     depending on whether `|x|` is less than 1 and on the sign of the infinity.
+//   - `PowF(+Inf, y)` is `+Inf` for `y > 0` and `+0` for `y < 0`.
+//   - `PowF(-Inf, y) = PowF(-0, -y)`.
 func PowF(self float32, n float32) float32 {
-	// using x^n = exp(ln(x^n)) = exp(n*ln(x))
-	if self >= 0.0 {
-		return Exp(n * Ln(self))
-	} else if IsInteger(n) {
+	switch {
+	case n == 0 || self == 1:
+		return 1
+	case n == 1:
+		return self
+	case IsNaN(self) || IsNaN(n):
 		return NaN
-	} else if IsEven(n) {
-		// if n is even, then we know that the result will have no sign, so we can remove it
-		return n * Exp(Ln(Abs(self)))
-	} else {
-		// if n isn't even, we need to multiply by -1.0 at the end.
-		return -(n * Exp(Ln(Abs(self))))
+	case self == 0:
+		odd := isOddInteger(n)
+		if n < 0 {
+			if odd {
+				return CopySign(Inf, self)
+			}
+			return Inf
+		}
+		if odd {
+			return self
+		}
+		return 0
+	case Abs(n) == Inf:
+		if self == -1 {
+			return 1
+		}
+		if (Abs(self) < 1) == (n > 0) {
+			return 0
+		}
+		return Inf
+	case self == Inf:
+		if n < 0 {
+			return 0
+		}
+		return Inf
+	case self == NegInf:
+		return PowF(CopySign(0, -1), -n)
+	}
+
+	if Abs(n) < 1<<31 && IsInteger(n) {
+		return PowI(self, int32(n))
 	}
+	if self < 0 {
+		if !IsInteger(n) {
+			return NaN
+		}
+		// Integers that don't fit into int32 are all even,
+		// so the result is positive.
+		self = -self
+	}
+	// using x^n = exp(ln(x^n)) = exp(n*ln(x))
+	return Exp(n * Ln(self))
+}
+
+// Checks if the number is an odd integer.
+func isOddInteger(x float32) bool {
+	return IsInteger(x) && !IsEven(x)
 }
 
 // Approximates a number raised to an integer power.
+//
+// The relative error grows with `|n|`: it stays below `|n|·1.2e-7`.
 func PowI(self float32, n int32) float32 {
 	base := self
 	abs_n := n
@@ -267,29 +355,9 @@ func PowI(self float32, n int32) float32 {
 		return self
 	}
 
-	// For values less than 2.0, but greater than 0.5 (1.0/2.0), you can multiply longer without
-	// going over exponent, i.e. 1.1 multiplied against itself will grow slowly.
-	abs := Abs(self)
-	if 0.5 <= abs && abs < 2.0 {
-		// Approximation if we end up outside of the range of floating point values,
-		// then we end early
-		approx_final_exponent := extractExponentValue(self) * n
-		const max_representable_exponent = 127
-		const min_representable_exponent = -126 - mantissaBits
-		if approx_final_exponent > max_representable_exponent || (self == 0.0 && approx_final_exponent < 0) {
-			if IsSignPositive(self) || n&1 == 0 {
-				return Inf
-			} else {
-				return NegInf
-			}
-		} else if approx_final_exponent < min_representable_exponent {
-			// We may want to copy the sign and do the same thing as above,
-			// but that seems like an awful amount of work when 99.99999% of people only care
-			// about bare zero
-			return 0.0
-		}
-	}
-
+	// There is no early exit for results out of the float32 range:
+	// the powers of the base overflow into Inf or underflow into 0 on their own,
+	// in at most 31 iterations.
 	for {
 		if (abs_n & 1) == 1 {
 			result *= base
@@ -313,6 +381,7 @@ func Recip(self float32) float32 {
 	x *= sx
 	v := FromBits(0x7EF1_27EA - ToBits(x))
 	w := x * v
+	// Three Newton-Raphson iterations fused into one polynomial.
 	v *= 8.0 + w*(-28.0+w*(56.0+w*(-70.0+w*(56.0+w*(-28.0+w*(8.0-w))))))
 	return v * sx
 }
@@ -327,11 +396,6 @@ func RemEuclid(self float32, rhs float32) float32 {
 	}
 }
 
-// Returns the nearest integer to a number.
-func Round(self float32) float32 {
-	return float32(int32(self + CopySign(0.5, self)))
-}
-
 // Returns a number that represents the sign of `self`.
 // /
 // * `1.0` if the number is positive, `+0.0` or `INFINITY`
@@ -358,11 +422,3 @@ func setExponent(self float32, exponent int32) float32 {
 	only_exponent := uint32(exponent+expBias) << mantissaBits
 	return FromBits(without_exponent | only_exponent)
 }
-
-func saturatingAdd(a, b int32) int32 {
-	c := a + b
-	if (c > a) == (b > 0) {
-		return c
-	}
-	return 2147483647
-}
@@ -150,6 +150,27 @@ func TestExp(t *testing.T) {
 		})
 	}
 
+	for x := float32(-87); x < 88; x += 0.0113 {
+		exp := float32(math.Exp(float64(x)))
+		close(t, tinymath.Exp(x), exp, exp*6e-6)
+	}
+}
+
+func TestExp2(t *testing.T) {
+	t.Parallel()
+	for x := float32(-126); x < 128; x += 0.0137 {
+		exp := float32(math.Exp2(float64(x)))
+		close(t, tinymath.Exp2(x), exp, exp*3e-7)
+	}
+	for i := int32(-149); i < 128; i++ {
+		same(t, tinymath.Exp2(float32(i)), float32(math.Exp2(float64(i))))
+	}
+	close(t, tinymath.Exp2(-140.5), float32(math.Exp2(-140.5)), 1e-45)
+	same(t, tinymath.Exp2(128), tinymath.Inf)
+	same(t, tinymath.Exp2(tinymath.Inf), tinymath.Inf)
+	same(t, tinymath.Exp2(-151), 0)
+	same(t, tinymath.Exp2(tinymath.NegInf), 0)
+	same(t, tinymath.Exp2(tinymath.NaN), tinymath.NaN)
 }
 
 func TestFloor(t *testing.T) {
@@ -381,6 +402,39 @@ func TestLn(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("precision", func(t *testing.T) {
+		t.Parallel()
+		xs := []float64{}
+		for x := 1e-44; x < 3e38; x *= 1.0013 {
+			xs = append(xs, x)
+		}
+		for x := 0.99; x < 1.01; x += 1.3e-6 {
+			xs = append(xs, x)
+		}
+		for _, x := range xs {
+			x := float32(x)
+			exp := math.Log(float64(x))
+			act := float64(tinymath.Ln(x))
+			if math.Abs(act-exp) > 3.5e-7*math.Abs(exp) {
+				t.Fatalf("Ln(%g): %g != %g", x, act, exp)
+			}
+			exp = math.Log10(float64(x))
+			act = float64(tinymath.Log10(x))
+			if math.Abs(act-exp) > 3.5e-7*math.Abs(exp) {
+				t.Fatalf("Log10(%g): %g != %g", x, act, exp)
+			}
+		}
+	})
+
+	t.Run("special", func(t *testing.T) {
+		t.Parallel()
+		same(t, tinymath.Ln(1), 0)
+		same(t, tinymath.Ln(0), tinymath.NegInf)
+		same(t, tinymath.Ln(tinymath.Inf), tinymath.Inf)
+		same(t, tinymath.Ln(-1), tinymath.NaN)
+		same(t, tinymath.Ln(tinymath.NaN), tinymath.NaN)
+	})
 }
 
 func TestLog(t *testing.T) {
@@ -597,6 +651,28 @@ func TestLog2(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("precision", func(t *testing.T) {
+		t.Parallel()
+		for x := 1e-44; x < 3e38; x *= 1.0013 {
+			exp := math.Log2(float64(float32(x)))
+			act := float64(tinymath.Log2(float32(x)))
+			if math.Abs(act-exp) > 3e-7*math.Max(math.Abs(exp), 1) {
+				t.Fatalf("Log2(%g): %g != %g", float32(x), act, exp)
+			}
+		}
+		for i := int32(-149); i < 128; i++ {
+			same(t, tinymath.Log2(tinymath.Ldexp(1, i)), float32(i))
+		}
+	})
+
+	t.Run("special", func(t *testing.T) {
+		t.Parallel()
+		same(t, tinymath.Log2(0), tinymath.NegInf)
+		same(t, tinymath.Log2(tinymath.Inf), tinymath.Inf)
+		same(t, tinymath.Log2(-1), tinymath.NaN)
+		same(t, tinymath.Log2(tinymath.NaN), tinymath.NaN)
+	})
 }
 
 func TestLog10(t *testing.T) {
@@ -810,6 +886,68 @@ func TestPowF(t *testing.T) {
 	})
 }
 
+func TestPowFStdlib(t *testing.T) {
+	t.Parallel()
+	negZero := tinymath.CopySign(0, -1)
+	bases := []float32{
+		0, negZero, 1, -1, 0.5, -0.5, 2, -2, 3.7, -3.7, 0.9, -1.1,
+		1e-10, -1e-10, 1e10, -1e10, 0.99999, 1.0001, 0.9999999,
+		tinymath.Inf, tinymath.NegInf, tinymath.NaN,
+	}
+	exps := []float32{
+		0, negZero, 1, -1, 2, -2, 3, -3, 10, -11, 0.5, -0.5, 2.5, -1.25, 1.0 / 3,
+		33, 101, 1000, -999, 100000, 10000.5, 1e-10, 0x1p30, 0x1p31, 1e10, -1e10, 0x1p24 + 2, -(0x1p24 + 2),
+		tinymath.Inf, tinymath.NegInf, tinymath.NaN,
+	}
+	for _, x := range bases {
+		for _, y := range exps {
+			x := x
+			y := y
+			t.Run(fmt.Sprintf("%g_%g", x, y), func(t *testing.T) {
+				exp := float32(math.Pow(float64(x), float64(y)))
+				act := tinymath.PowF(x, y)
+				// Special values (and zero with its sign) must be exact.
+				if tinymath.IsNaN(exp) || tinymath.Abs(exp) == tinymath.Inf || exp == 0 || tinymath.Abs(exp) == 1 {
+					same(t, act, exp)
+					return
+				}
+				if tinymath.Sign(act) != tinymath.Sign(exp) {
+					t.Fatalf("%g != %g", act, exp)
+				}
+				// The result can be rounded to zero or overflow a bit earlier than in float64.
+				if exp < 1e-30 && exp > -1e-30 || exp > 1e30 || exp < -1e30 {
+					return
+				}
+				// The error of PowI grows with the power.
+				eps := float32(2e-5)
+				if tinymath.IsInteger(y) && tinymath.Abs(y) < 0x1p31 {
+					eps = 1.2e-7 * tinymath.Abs(y)
+				}
+				if tinymath.Abs(act-exp) > eps*tinymath.Abs(exp) {
+					t.Fatalf("%g != %g", act, exp)
+				}
+			})
+		}
+	}
+}
+
+func TestPowFIntegerExponent(t *testing.T) {
+	t.Parallel()
+	for n := -20; n <= 20; n++ {
+		for x := float32(-4); x <= 4; x += 0.25 {
+			if x == 0 {
+				continue
+			}
+			exp := float32(math.Pow(float64(x), float64(n)))
+			act := tinymath.PowF(x, float32(n))
+			if tinymath.Abs(act-exp) > 1e-5*tinymath.Abs(exp) {
+				t.Fatalf("PowF(%g, %d) = %g, want %g", x, n, act, exp)
+			}
+			same(t, act, tinymath.PowI(x, int32(n)))
+		}
+	}
+}
+
 func TestPowI(t *testing.T) {
 	t.Parallel()
 	for i := int32(1); i < 10; i++ {
@@ -824,6 +962,11 @@ func TestPowI(t *testing.T) {
 			})
 		}
 	}
+	close(t, tinymath.PowI(0.99999, 100000), 0.36737835, 1e-4)
+	close(t, tinymath.PowI(1.0001, 10000), 2.7185969, 1e-3)
+	same(t, tinymath.PowI(0.5, -127), 0x1p127)
+	same(t, tinymath.PowI(0.5, 150), 0)
+	same(t, tinymath.PowI(-2, 129), tinymath.NegInf)
 }
 
 func TestRecip(t *testing.T) {
@@ -865,35 +1008,6 @@ func TestRemEuclid(t *testing.T) {
 	}
 }
 
-func TestRound(t *testing.T) {
-	t.Parallel()
-	cases := []Case{
-		{0.0, 0.0},
-		{0.49999, 0.0},
-		{-0.49999, 0.0},
-		{0.5, 1.0},
-		{-0.5, -1.0},
-		{9999.499, 9999.0},
-		{-9999.499, -9999.0},
-		{9999.5, 10000.0},
-		{-9999.5, -10000.0},
-	}
-	for _, c := range cases {
-		c := c
-		t.Run(fmt.Sprintf("%f", c.Given), func(t *testing.T) {
-			act := tinymath.Round(c.Given)
-			eq(t, act, c.Expected)
-		})
-	}
-
-	for i := float32(-20.); i < 20.; i += .34 {
-		i := i
-		t.Run(fmt.Sprintf("%f", i), func(t *testing.T) {
-			eq(t, tinymath.Round(i), float32(math.Round(float64(i))))
-		})
-	}
-}
-
 func TestSign(t *testing.T) {
 	t.Parallel()
 	cases := []Case{
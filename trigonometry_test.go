@@ -37,6 +37,37 @@ func TestAsin(t *testing.T) {
 	close(t, act, tinymath.FracPi2, tinymath.Epsilon)
 }
 
+func TestAsinAcosAccuracy(t *testing.T) {
+	t.Parallel()
+	for i := 0; i <= 1<<20; i++ {
+		x := float32(i)/(1<<19) - 1
+		close(t, tinymath.Asin(x), float32(math.Asin(float64(x))), 5e-7)
+		close(t, tinymath.Acos(x), float32(math.Acos(float64(x))), 6e-7)
+	}
+}
+
+func TestAsinAcosEndpoints(t *testing.T) {
+	t.Parallel()
+	same(t, tinymath.Asin(0), 0)
+	same(t, tinymath.Asin(tinymath.CopySign(0, -1)), tinymath.CopySign(0, -1))
+	same(t, tinymath.Asin(1e-10), 1e-10)
+	same(t, tinymath.Asin(1), tinymath.FracPi2)
+	same(t, tinymath.Asin(-1), -tinymath.FracPi2)
+	same(t, tinymath.Acos(1), 0)
+	same(t, tinymath.Acos(-1), tinymath.Pi)
+	close(t, tinymath.Acos(0), tinymath.FracPi2, 6e-7)
+	// Near the endpoints, the derivative is infinite.
+	for _, x := range []float32{tinymath.Nextdown(1), 0.9999, 0.999, -0.999, -0.9999, tinymath.Nextup(-1)} {
+		close(t, tinymath.Asin(x), float32(math.Asin(float64(x))), 5e-7)
+		close(t, tinymath.Acos(x), float32(math.Acos(float64(x))), 6e-7)
+	}
+	outside := []float32{tinymath.Nextup(1), tinymath.Nextdown(-1), 2, -2, tinymath.Inf, tinymath.NegInf, tinymath.NaN}
+	for _, x := range outside {
+		same(t, tinymath.Asin(x), tinymath.NaN)
+		same(t, tinymath.Acos(x), tinymath.NaN)
+	}
+}
+
 func TestAtan(t *testing.T) {
 	cases := []Case{
 		// {tinymath.Sqrt(3.0) / 3.0, tinymath.FRAC_PI_6},
@@ -60,7 +91,8 @@ func TestAtan2(t *testing.T) {
 		{0.0, -1.0, tinymath.Pi},
 		{3.0, 2.0, tinymath.Atan(3.0 / 2.0)},
 		{2.0, -1.0, tinymath.Atan(2.0/-1.0) + tinymath.Pi},
-		// {-2.0, -1.0, tinymath.Atan(-2.0/-1.0) - tinymath.PI},
+		{-2.0, -1.0, tinymath.Atan(-2.0/-1.0) - tinymath.Pi},
+		{-2.0, 1.0, tinymath.Atan(-2.0 / 1.0)},
 	}
 	for _, c := range cases {
 		c := c